@@ -0,0 +1,153 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Package analysis turns text into index terms, like the analyzers of
+// Lucene: text is split into tokens, which are normalized, filtered and
+// stemmed.
+package analysis // import "xojoc.pw/nlp/analysis"
+
+import (
+	"io"
+	"strings"
+
+	"xojoc.pw/nlp/stem"
+	"xojoc.pw/nlp/tokenize"
+)
+
+// Set is a set of normalized words, like stem.WordSet.
+type Set interface {
+	Contains(word []byte) bool
+}
+
+// Analyzer is a pipeline that splits text with a tokenize.Scanner,
+// normalizes the tokens with Stemmer.NormalizeBytes, removes the
+// Stopwords and stems the words with Stemmer.StemBytes, unless they are
+// Keywords. Numbers, URLs and email addresses are only normalized.
+type Analyzer struct {
+	// Stemmer normalizes and stems the tokens. Use stem.Steps{} to
+	// only normalize them.
+	Stemmer stem.Interface
+	// Stopwords, if not nil, are removed.
+	Stopwords Set
+	// Keywords, if not nil, are protected from stemming.
+	Keywords Set
+	// Elisions are passed to the tokenizer. Elided words are dropped.
+	Elisions map[string]bool
+}
+
+// Term is an index term.
+type Term struct {
+	// Bytes is the term: the token normalized and stemmed.
+	Bytes []byte
+	Kind  tokenize.Kind
+	// Position is the index of the token in the text. Removed
+	// stopwords count, so that phrase queries don't match across
+	// them.
+	Position int
+	// Start and End are the byte offsets of the token in the input.
+	Start, End int
+}
+
+// Scanner reads the terms of an io.Reader. Like bufio.Scanner, it's
+// used by calling Scan until it returns false and then checking Err.
+type Scanner struct {
+	a        *Analyzer
+	sc       *tokenize.Scanner
+	position int
+	buf      []byte
+	term     Term
+}
+
+// NewScanner returns a Scanner which analyzes the text read from r.
+func (a *Analyzer) NewScanner(r io.Reader) *Scanner {
+	sc := tokenize.NewScanner(r)
+	sc.Elisions = a.Elisions
+	return &Scanner{a: a, sc: sc, position: -1}
+}
+
+// Scan advances to the next term, which is then available with Term.
+// It returns false at the end of the input or on error.
+func (s *Scanner) Scan() bool {
+	for s.sc.Scan() {
+		tok := s.sc.Token()
+		if tok.Kind == tokenize.Elision {
+			continue
+		}
+		s.position++
+		s.buf = append(s.buf[:0], tok.Bytes...)
+		b := s.a.Stemmer.NormalizeBytes(s.buf)
+		if tok.Kind == tokenize.Word {
+			if s.a.Stopwords != nil && s.a.Stopwords.Contains(b) {
+				continue
+			}
+			if s.a.Keywords == nil || !s.a.Keywords.Contains(b) {
+				b = s.a.Stemmer.StemBytes(b)
+			}
+		}
+		if len(b) == 0 {
+			continue
+		}
+		s.term = Term{Bytes: b, Kind: tok.Kind, Position: s.position, Start: tok.Start, End: tok.End}
+		return true
+	}
+	return false
+}
+
+// Term returns the last term read by Scan. Its Bytes are only valid
+// until the next call to Scan.
+func (s *Scanner) Term() Term {
+	return s.term
+}
+
+// Err returns the first error, other than io.EOF, met by Scan.
+func (s *Scanner) Err() error {
+	return s.sc.Err()
+}
+
+// AnalyzeString returns all the terms of text.
+func (a *Analyzer) AnalyzeString(text string) []Term {
+	var terms []Term
+	s := a.NewScanner(strings.NewReader(text))
+	for s.Scan() {
+		t := s.Term()
+		t.Bytes = append([]byte(nil), t.Bytes...)
+		terms = append(terms, t)
+	}
+	return terms
+}
+
+func stopwords(code string) *stem.WordSet {
+	s, err := stem.Stopwords(code)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// English returns an analyzer for English text.
+func English() *Analyzer {
+	return &Analyzer{Stemmer: stem.Porter2English{}, Stopwords: stopwords("en")}
+}
+
+// Italian returns an analyzer for Italian text. Elided articles and
+// prepositions, like l' and dell', are removed.
+func Italian() *Analyzer {
+	return &Analyzer{Stemmer: stem.Porter2Italian{}, Stopwords: stopwords("it"), Elisions: tokenize.ItalianElisions}
+}
+
+// Spanish returns an analyzer for Spanish text.
+func Spanish() *Analyzer {
+	return &Analyzer{Stemmer: stem.Porter2Spanish{}, Stopwords: stopwords("es")}
+}
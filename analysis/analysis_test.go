@@ -0,0 +1,127 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package analysis_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"xojoc.pw/nlp/analysis"
+	"xojoc.pw/nlp/stem"
+)
+
+// terms formats the terms as term@position.
+func terms(ts []analysis.Term) string {
+	var s []string
+	for _, t := range ts {
+		s = append(s, fmt.Sprintf("%s@%d", t.Bytes, t.Position))
+	}
+	return strings.Join(s, " ")
+}
+
+func TestAnalyzers(t *testing.T) {
+	tests := []struct {
+		a    *analysis.Analyzer
+		text string
+		want string
+	}{
+		{analysis.English(), "The Dogs’ owners were RUNNING to https://Example.com in 2018!",
+			"dog@1 owner@2 run@4 https://example.com@6 2018@8"},
+		{analysis.English(), "I don't think it's generously communicated",
+			"think@2 generous@4 communic@5"},
+		{analysis.Italian(), "L'amico dell'anno è arrivato nella città",
+			"amic@0 anno@1 arriv@3 citt@5"},
+		{analysis.Spanish(), "Los niños estaban jugando en el PARQUE",
+			"niñ@1 jug@3 parqu@6"},
+	}
+	for _, tt := range tests {
+		if got := terms(tt.a.AnalyzeString(tt.text)); got != tt.want {
+			t.Errorf("%q\n got %s\nwant %s", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestOffsets(t *testing.T) {
+	text := "  L’amico di Maria, ‘generosamente’…"
+	for _, term := range analysis.Italian().AnalyzeString(text) {
+		if term.Start < 0 || term.End > len(text) || term.Start >= term.End {
+			t.Fatalf("term %q has offsets [%d:%d]", term.Bytes, term.Start, term.End)
+		}
+		if got := string(stem.Porter2Italian{}.StemBytes(stem.Porter2Italian{}.NormalizeBytes([]byte(text[term.Start:term.End])))); got != string(term.Bytes) {
+			t.Errorf("term %q at [%d:%d] is %q", term.Bytes, term.Start, term.End, got)
+		}
+	}
+}
+
+type set map[string]bool
+
+func (s set) Contains(b []byte) bool {
+	return s[string(b)]
+}
+
+func TestKeywords(t *testing.T) {
+	a := analysis.English()
+	a.Keywords = set{"running": true}
+	if got := terms(a.AnalyzeString("Running runners")); got != "running@0 runner@1" {
+		t.Errorf("got %s", got)
+	}
+	a = &analysis.Analyzer{Stemmer: stem.Steps{}}
+	if got := terms(a.AnalyzeString("The Running runners")); got != "the@0 running@1 runners@2" {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestScanner(t *testing.T) {
+	text := strings.Repeat("The runners were running quickly. ", 1000)
+	s := analysis.English().NewScanner(strings.NewReader(text))
+	n := 0
+	for s.Scan() {
+		term := s.Term()
+		if want := []string{"runner", "run", "quick"}[n%3]; string(term.Bytes) != want {
+			t.Fatalf("term %d is %q; want %q", n, term.Bytes, want)
+		}
+		if want := n/3*5 + []int{1, 3, 4}[n%3]; term.Position != want {
+			t.Fatalf("term %d at position %d; want %d", n, term.Position, want)
+		}
+		n++
+	}
+	if s.Err() != nil || n != 3000 {
+		t.Errorf("got %d terms, error %v", n, s.Err())
+	}
+}
+
+func BenchmarkEnglish(b *testing.B) {
+	text := strings.Repeat("The runners were running quickly to https://example.com. ", 1000)
+	a := analysis.English()
+	b.SetBytes(int64(len(text)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := a.NewScanner(strings.NewReader(text))
+		for s.Scan() {
+		}
+	}
+}
+
+func ExampleAnalyzer() {
+	a := analysis.Italian()
+	for _, t := range a.AnalyzeString("L'amico di Marco") {
+		fmt.Println(t.Position, t.Start, t.End, string(t.Bytes))
+	}
+	//Output:
+	// 0 2 7 amic
+	// 2 11 16 marc
+}
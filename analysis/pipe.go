@@ -0,0 +1,237 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package analysis
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"runtime"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// chunkSize is the size of the chunks of text given to the workers of
+// Pipe.
+const chunkSize = 64 << 10
+
+// chunk is a chunk of the input of Pipe: whole lines or a piece of a
+// line longer than chunkSize.
+type chunk struct {
+	seq  int
+	text []byte
+	out  []byte
+	err  error
+}
+
+// Pipe reads text from src and writes its terms to dst, keeping its
+// lines: the terms of each line are written on a line, separated by a
+// space. The text is split in chunks which are analyzed by workers
+// goroutines, or GOMAXPROCS if workers isn't positive, so a must be safe
+// for concurrent use. The analyzers of this package and the stemmers of
+// package stem are.
+//
+// Pipe stops at the end of src, at the first error or when ctx is done
+// and returns the error, if any. The terms of the text before the
+// error are written. If ctx is done while src.Read blocks, Pipe
+// returns without waiting for it.
+//
+// Use an Analyzer with only a Stemmer to stem the text without
+// removing stopwords.
+func (a *Analyzer) Pipe(ctx context.Context, dst io.Writer, src io.Reader, workers int) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan *chunk)
+	results := make(chan *chunk)
+	// inflight bounds the chunks read but not yet written
+	inflight := make(chan struct{}, 2*workers)
+
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(jobs)
+		br := bufio.NewReaderSize(src, chunkSize)
+		var rest []byte
+		for seq := 0; ; seq++ {
+			var text []byte
+			var err error
+			text, rest, err = readChunk(br, rest)
+			if len(text) > 0 {
+				select {
+				case inflight <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+				select {
+				case jobs <- &chunk{seq: seq, text: text}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				if err != io.EOF {
+					readErr <- err
+				}
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				var c *chunk
+				select {
+				case c = <-jobs:
+				case <-ctx.Done():
+					return
+				}
+				if c == nil {
+					return
+				}
+				c.out, c.err = a.appendLines(nil, c.text)
+				select {
+				case results <- c:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var err error
+	pending := map[int]*chunk{}
+	next := 0
+	// open is true if the output ends with a term of a line which
+	// continues in the next chunk
+	open := false
+	for c := range results {
+		if err != nil {
+			continue
+		}
+		pending[c.seq] = c
+		for c := pending[next]; c != nil; c = pending[next] {
+			delete(pending, next)
+			next++
+			if c.err == nil && open && len(c.out) > 0 && c.out[0] != '\n' {
+				_, c.err = dst.Write(space)
+			}
+			if c.err == nil {
+				_, c.err = dst.Write(c.out)
+			}
+			if len(c.out) > 0 {
+				open = c.out[len(c.out)-1] != '\n'
+			}
+			if c.err != nil {
+				err = c.err
+				cancel()
+				break
+			}
+			<-inflight
+		}
+	}
+	if err == nil {
+		select {
+		case err = <-readErr:
+		default:
+		}
+	}
+	if err == nil {
+		err = parent.Err()
+	}
+	return err
+}
+
+// readChunk reads rest and then whole lines from br until chunkSize
+// bytes are read or the end of the input. A line longer than chunkSize
+// is cut at its last space, so that terms aren't split, or else at the
+// last character boundary: the text after the cut is returned as rest,
+// to be passed to the next call.
+func readChunk(br *bufio.Reader, rest []byte) (text, newRest []byte, err error) {
+	text = append(text, rest...)
+	for len(text) < chunkSize {
+		line, err := br.ReadSlice('\n')
+		text = append(text, line...)
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err != nil {
+			return text, nil, err
+		}
+	}
+	if text[len(text)-1] == '\n' {
+		return text, nil, nil
+	}
+	if i := bytes.LastIndexFunc(text, unicode.IsSpace); i >= 0 {
+		_, n := utf8.DecodeRune(text[i:])
+		return text[:i+n], text[i+n:], nil
+	}
+	// cut before the last character if it's incomplete
+	i := len(text)
+	for j := len(text) - 1; j > 0 && j > len(text)-utf8.UTFMax; j-- {
+		if utf8.RuneStart(text[j]) {
+			if !utf8.FullRune(text[j:]) {
+				i = j
+			}
+			break
+		}
+	}
+	return text[:i], text[i:], nil
+}
+
+// appendLines appends to out the terms of text, separated by a space or
+// by the newlines between them.
+func (a *Analyzer) appendLines(out, text []byte) ([]byte, error) {
+	s := a.NewScanner(bytes.NewReader(text))
+	prev := 0
+	for s.Scan() {
+		t := s.Term()
+		if n := bytes.Count(text[prev:t.Start], newline); n > 0 {
+			out = appendNewlines(out, n)
+		} else if prev > 0 {
+			out = append(out, ' ')
+		}
+		out = append(out, t.Bytes...)
+		prev = t.End
+	}
+	out = appendNewlines(out, bytes.Count(text[prev:], newline))
+	return out, s.Err()
+}
+
+var (
+	newline = []byte{'\n'}
+	space   = []byte{' '}
+)
+
+func appendNewlines(out []byte, n int) []byte {
+	for ; n > 0; n-- {
+		out = append(out, '\n')
+	}
+	return out
+}
@@ -0,0 +1,224 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package analysis_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/analysis"
+	"xojoc.pw/nlp/stem"
+)
+
+// corpus returns about n bytes of lines of English words.
+func corpus(tb testing.TB, n int) string {
+	f, err := os.Open("../stem/internal/porter2english/testfiles/vocabulary.txt")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer f.Close()
+	var words []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if fields := strings.Fields(sc.Text()); len(fields) > 0 {
+			words = append(words, fields[0])
+		}
+	}
+	r := rand.New(rand.NewSource(1))
+	var b strings.Builder
+	for b.Len() < n {
+		switch r.Intn(20) {
+		case 0:
+			b.WriteString("\n")
+		case 1:
+			b.WriteString(". The ")
+		default:
+			b.WriteString(words[r.Intn(len(words))] + " ")
+		}
+	}
+	return b.String()
+}
+
+// pipe is the sequential reference of Analyzer.Pipe.
+func pipe(a *analysis.Analyzer, text string) string {
+	lines := strings.Split(text, "\n")
+	for i, l := range lines {
+		var terms []string
+		for _, t := range a.AnalyzeString(l) {
+			terms = append(terms, string(t.Bytes))
+		}
+		lines[i] = strings.Join(terms, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestPipe(t *testing.T) {
+	text := corpus(t, 1<<20)
+	for _, a := range []*analysis.Analyzer{analysis.English(), {Stemmer: stem.Porter2English{}}} {
+		want := pipe(a, text)
+		for _, workers := range []int{0, 1, 3, 16} {
+			var out bytes.Buffer
+			if err := a.Pipe(context.Background(), &out, strings.NewReader(text), workers); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != want {
+				t.Errorf("%d workers: output differs from the sequential one", workers)
+			}
+		}
+	}
+	for _, text := range []string{"", "\n\n", "The end", "\n  one\n\ntwo  \nthree\n"} {
+		var out bytes.Buffer
+		if err := analysis.English().Pipe(context.Background(), &out, strings.NewReader(text), 2); err != nil {
+			t.Fatal(err)
+		}
+		if want := pipe(analysis.English(), text); out.String() != want {
+			t.Errorf("Pipe(%q) = %q; want %q", text, out.String(), want)
+		}
+	}
+}
+
+func TestPipeLongLines(t *testing.T) {
+	// lines longer than the chunks of Pipe
+	long := strings.Replace(corpus(t, 1<<19), "\n", " ", -1)
+	for _, text := range []string{
+		long,
+		"first\n" + long + "\n\n" + long + "\nlast\n",
+		"città " + strings.Repeat("perché  città\t", 40000) + "\nend",
+	} {
+		a := analysis.English()
+		want := pipe(a, text)
+		for _, workers := range []int{1, 4} {
+			var out bytes.Buffer
+			if err := a.Pipe(context.Background(), &out, strings.NewReader(text), workers); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != want {
+				t.Errorf("%d workers: output differs from the sequential one", workers)
+			}
+		}
+	}
+
+	// a term longer than the chunks is cut, but not inside a character
+	text := "x " + strings.Repeat("è", 100000) + " y\nz"
+	var out bytes.Buffer
+	a := &analysis.Analyzer{Stemmer: stem.Steps{}}
+	if err := a.Pipe(context.Background(), &out, strings.NewReader(text), 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := out.String(); !utf8.ValidString(got) || !strings.HasPrefix(got, "x è") || !strings.HasSuffix(got, "è y\nz") ||
+		strings.Count(got, "è") != 100000 {
+		t.Errorf("Pipe of a long term = %.20q...", got)
+	}
+}
+
+var errTest = errors.New("test error")
+
+type failingWriter struct{ n int }
+
+func (w *failingWriter) Write(b []byte) (int, error) {
+	if w.n == 0 {
+		return 0, errTest
+	}
+	w.n--
+	return len(b), nil
+}
+
+func TestPipeErrors(t *testing.T) {
+	text := corpus(t, 1<<20)
+	a := analysis.English()
+	var out bytes.Buffer
+	r := io.MultiReader(strings.NewReader(text), iotestErrReader{})
+	if err := a.Pipe(context.Background(), &out, r, 4); err != errTest {
+		t.Errorf("read error: Pipe returned %v", err)
+	}
+	if !strings.HasPrefix(pipe(a, text), out.String()) || out.Len() == 0 {
+		t.Errorf("read error: the output isn't a prefix of the expected one")
+	}
+	if err := a.Pipe(context.Background(), &failingWriter{n: 3}, strings.NewReader(text), 4); err != errTest {
+		t.Errorf("write error: Pipe returned %v", err)
+	}
+}
+
+type iotestErrReader struct{}
+
+func (iotestErrReader) Read([]byte) (int, error) {
+	return 0, errTest
+}
+
+// blockingReader returns text and then blocks until ctx is done.
+type blockingReader struct {
+	r   io.Reader
+	ctx context.Context
+}
+
+func (b blockingReader) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	if err == io.EOF {
+		<-b.ctx.Done()
+	}
+	return n, err
+}
+
+func TestPipeCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	block, unblock := context.WithCancel(context.Background())
+	defer unblock()
+	r := blockingReader{strings.NewReader(corpus(t, 1<<18)), block}
+	done := make(chan error)
+	go func() {
+		done <- analysis.English().Pipe(ctx, io.Discard, r, 4)
+	}()
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Pipe returned %v; want %v", err, context.Canceled)
+	}
+}
+
+func BenchmarkPipe(b *testing.B) {
+	text := corpus(b, 4<<20)
+	a := &analysis.Analyzer{Stemmer: stem.Porter2English{}}
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprint(workers), func(b *testing.B) {
+			b.SetBytes(int64(len(text)))
+			for i := 0; i < b.N; i++ {
+				if err := a.Pipe(context.Background(), io.Discard, strings.NewReader(text), workers); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func ExampleAnalyzer_Pipe() {
+	text := "The runners were running.\n\nThe end."
+	a := &analysis.Analyzer{Stemmer: stem.Porter2English{}}
+	if err := a.Pipe(context.Background(), os.Stdout, strings.NewReader(text), 4); err != nil {
+		fmt.Println(err)
+	}
+	//Output:
+	// the runner were run
+	//
+	// the end
+}
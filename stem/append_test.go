@@ -0,0 +1,145 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem_test
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"xojoc.pw/nlp/stem"
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+var appendStemmers = []struct {
+	name       string
+	st         stem.Interface
+	vocabulary string
+}{
+	{"Porter2Danish", stem.Porter2Danish{}, "porter2danish"},
+	{"Porter2Dutch", stem.Porter2Dutch{}, "porter2dutch"},
+	{"Porter2English", stem.Porter2English{}, "porter2english"},
+	{"Porter2Finnish", stem.Porter2Finnish{}, "porter2finnish"},
+	{"Porter2French", stem.Porter2French{}, "porter2french"},
+	{"Porter2German", stem.Porter2German{}, "porter2german"},
+	{"Porter2Hungarian", stem.Porter2Hungarian{}, "porter2hungarian"},
+	{"Porter2Italian", stem.Porter2Italian{}, "porter2italian"},
+	{"Porter2Norwegian", stem.Porter2Norwegian{}, "porter2norwegian"},
+	{"Porter2Portuguese", stem.Porter2Portuguese{}, "porter2portuguese"},
+	{"Porter2Russian", stem.Porter2Russian{}, "porter2russian"},
+	{"Porter2Spanish", stem.Porter2Spanish{}, "porter2spanish"},
+	{"Porter2Swedish", stem.Porter2Swedish{}, "porter2swedish"},
+	{"PorterEnglish", stem.PorterEnglish{}, "porterenglish"},
+	{"KraaijPohlmann", stem.KraaijPohlmann{}, "kraaijpohlmann"},
+	{"Lancaster", stem.Lancaster{}, "lancaster"},
+	{"Lovins", stem.Lovins{}, "lovins"},
+}
+
+// vocabulary returns the words of the vocabulary of the internal
+// package pkg.
+func vocabulary(tb testing.TB, pkg string) []string {
+	f, err := os.Open("internal/" + pkg + "/testfiles/vocabulary.txt")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer f.Close()
+	var words []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			words = append(words, fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return words
+}
+
+var appendWords = strings.Fields(`a 'tis skies dying generously communication
+	abbandonata abbandonate cheveux gerechtigheid aufeinanderfolgenden
+	kirjoittamassa edzésekben надавно bibliotekernes trädgårdarna
+	ankommende lichamelijke nacionalizaciones`)
+
+func TestAppendStem(t *testing.T) {
+	for _, a := range appendStemmers {
+		for _, w := range appendWords {
+			src := []byte(w)
+			dst := []byte("prefix ")
+			dst = a.st.AppendStem(dst, src)
+			if string(src) != w {
+				t.Errorf("%s.AppendStem modified src %q: %q", a.name, w, src)
+			}
+			want := "prefix " + a.st.StemString(w)
+			if string(dst) != want {
+				t.Errorf("%s.AppendStem(%q) = %q; want %q", a.name, w, dst, want)
+			}
+		}
+	}
+}
+
+func TestAppendStemAllocs(t *testing.T) {
+	for _, a := range appendStemmers {
+		var words [][]byte
+		for _, w := range append(appendWords, vocabulary(t, a.vocabulary)...) {
+			words = append(words, []byte(w))
+		}
+		dst := make([]byte, 0, 256)
+		allocs := testing.AllocsPerRun(1, func() {
+			for _, src := range words {
+				dst = a.st.AppendStem(dst[:0], src)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("%s.AppendStem allocates %v times over its vocabulary", a.name, allocs)
+		}
+	}
+}
+
+func TestAppendStemSteps(t *testing.T) {
+	st := stem.Steps{}
+	src := []byte("word")
+	if dst := st.AppendStem([]byte("a "), src); !bytes.Equal(dst, []byte("a word")) {
+		t.Errorf("Steps{}.AppendStem = %q; want %q", dst, "a word")
+	}
+
+	st = stem.Steps{
+		porter2.MustNewStep([]porter2.Suffix{{Suffixes: "s es", Callback: porter2.Delete}}),
+		porter2.MustNewStep([]porter2.Suffix{{Suffixes: "ing", Callback: porter2.Replace("e")}}),
+	}
+	dst := make([]byte, 0, 64)
+	for _, w := range []string{"words", "boxes", "making", "word"} {
+		src := []byte(w)
+		allocs := testing.AllocsPerRun(100, func() {
+			dst = st.AppendStem(dst[:0], src)
+		})
+		if allocs != 0 {
+			t.Errorf("Steps.AppendStem(%q) allocates %v times", w, allocs)
+		}
+	}
+}
+
+func BenchmarkAppendStem(b *testing.B) {
+	st := stem.Porter2English{}
+	dst := make([]byte, 0, 64)
+	src := []byte("communication")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst = st.AppendStem(dst[:0], src)
+	}
+}
@@ -0,0 +1,193 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Cache is a stemmer that remembers the stems of the most used words.
+// Since word frequencies follow Zipf's law, a small cache serves most
+// of the words of a text. It's safe for concurrent use.
+//
+// The cache is split in shards, each with its own lock, and uses the
+// CLOCK algorithm to evict entries, which only needs a read lock on
+// hits.
+type Cache struct {
+	st     Interface
+	shards []cacheShard
+}
+
+type cacheShard struct {
+	mu      sync.RWMutex
+	index   map[string]int
+	entries []cacheEntry
+	size    int
+	hand    int
+	// hits and misses are counted by shard so that goroutines using
+	// different shards don't contend for them.
+	hits   uint64
+	misses uint64
+}
+
+type cacheEntry struct {
+	word, stem string
+	// referenced is set by hits and cleared by the clock hand.
+	referenced uint32
+}
+
+var _ Interface = (*Cache)(nil)
+
+// Cached returns a stemmer that caches the stems of up to size words
+// computed by st. StemBytes modifies its argument like the StemBytes of
+// st would. Cached panics if size isn't positive.
+func Cached(st Interface, size int) *Cache {
+	if size <= 0 {
+		panic("stem: Cached size must be positive")
+	}
+	n := 1
+	for n < 64 && size/(2*n) >= 256 {
+		n *= 2
+	}
+	c := &Cache{st: st, shards: make([]cacheShard, n)}
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.size = size / n
+		if i < size%n {
+			s.size++
+		}
+		s.index = make(map[string]int, s.size)
+	}
+	return c
+}
+
+// Hits returns the number of lookups served by the cache.
+func (c *Cache) Hits() uint64 {
+	var n uint64
+	for i := range c.shards {
+		n += atomic.LoadUint64(&c.shards[i].hits)
+	}
+	return n
+}
+
+// Misses returns the number of lookups which called the wrapped stemmer.
+func (c *Cache) Misses() uint64 {
+	var n uint64
+	for i := range c.shards {
+		n += atomic.LoadUint64(&c.shards[i].misses)
+	}
+	return n
+}
+
+// shard returns the shard of word, chosen with FNV-1a.
+func (c *Cache) shard(word []byte) *cacheShard {
+	if len(c.shards) == 1 {
+		return &c.shards[0]
+	}
+	h := uint32(2166136261)
+	for _, b := range word {
+		h = (h ^ uint32(b)) * 16777619
+	}
+	return &c.shards[h%uint32(len(c.shards))]
+}
+
+func (s *cacheShard) get(word []byte) (string, bool) {
+	s.mu.RLock()
+	i, ok := s.index[string(word)]
+	var stem string
+	if ok {
+		e := &s.entries[i]
+		stem = e.stem
+		if atomic.LoadUint32(&e.referenced) == 0 {
+			atomic.StoreUint32(&e.referenced, 1)
+		}
+	}
+	s.mu.RUnlock()
+	if ok {
+		atomic.AddUint64(&s.hits, 1)
+	} else {
+		atomic.AddUint64(&s.misses, 1)
+	}
+	return stem, ok
+}
+
+func (s *cacheShard) put(word, stem string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.index[word]; ok {
+		// added by another goroutine in the meantime
+		return
+	}
+	if len(s.entries) < s.size {
+		s.index[word] = len(s.entries)
+		s.entries = append(s.entries, cacheEntry{word: word, stem: stem})
+		return
+	}
+	for {
+		e := &s.entries[s.hand]
+		if atomic.LoadUint32(&e.referenced) == 0 {
+			delete(s.index, e.word)
+			*e = cacheEntry{word: word, stem: stem}
+			s.index[word] = s.hand
+			s.hand = (s.hand + 1) % len(s.entries)
+			return
+		}
+		atomic.StoreUint32(&e.referenced, 0)
+		s.hand = (s.hand + 1) % len(s.entries)
+	}
+}
+
+func (c *Cache) StemBytes(b []byte) []byte {
+	s := c.shard(b)
+	if stem, ok := s.get(b); ok {
+		return append(b[:0], stem...)
+	}
+	word := string(b)
+	b = c.st.StemBytes(b)
+	s.put(word, string(b))
+	return b
+}
+func (c *Cache) AppendStem(dst, src []byte) []byte {
+	s := c.shard(src)
+	if stem, ok := s.get(src); ok {
+		return append(dst, stem...)
+	}
+	n := len(dst)
+	dst = c.st.AppendStem(dst, src)
+	s.put(string(src), string(dst[n:]))
+	return dst
+}
+func (c *Cache) StemString(str string) string {
+	s := c.shard([]byte(str))
+	if stem, ok := s.get([]byte(str)); ok {
+		return stem
+	}
+	stem := c.st.StemString(str)
+	s.put(str, stem)
+	return stem
+}
+func (c *Cache) NormalizeBytes(b []byte) []byte {
+	return c.st.NormalizeBytes(b)
+}
+func (c *Cache) NormalizeString(s string) string {
+	return c.st.NormalizeString(s)
+}
+
+// Explain isn't cached.
+func (c *Cache) Explain(s string) []Trace {
+	return Explain(c.st, s)
+}
@@ -0,0 +1,135 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem_test
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"xojoc.pw/nlp/stem"
+)
+
+func englishVocabulary(tb testing.TB) []string {
+	return vocabulary(tb, "porter2english")
+}
+
+// zipf returns n words of vocabulary drawn with Zipf's law, with the
+// ranks assigned at random.
+func zipf(vocabulary []string, n int) [][]byte {
+	r := rand.New(rand.NewSource(1))
+	rank := r.Perm(len(vocabulary))
+	z := rand.NewZipf(r, 1.1, 1, uint64(len(vocabulary)-1))
+	words := make([][]byte, n)
+	for i := range words {
+		words[i] = []byte(vocabulary[rank[z.Uint64()]])
+	}
+	return words
+}
+
+func TestCached(t *testing.T) {
+	st := stem.Porter2English{}
+	words := zipf(englishVocabulary(t), 50000)
+	for _, size := range []int{1, 100, 10000, 100000} {
+		c := stem.Cached(st, size)
+		for i, w := range words {
+			want := st.StemString(string(w))
+			var got string
+			switch i % 3 {
+			case 0:
+				got = string(c.StemBytes(append([]byte(nil), w...)))
+			case 1:
+				got = string(c.AppendStem([]byte("x"), w)[1:])
+			case 2:
+				got = c.StemString(string(w))
+			}
+			if got != want {
+				t.Fatalf("size %d: stem of %q is %q; want %q", size, w, got, want)
+			}
+		}
+		if c.Hits()+c.Misses() != uint64(len(words)) {
+			t.Errorf("size %d: %d hits + %d misses != %d", size, c.Hits(), c.Misses(), len(words))
+		}
+		if size >= 10000 && c.Hits() < c.Misses() {
+			t.Errorf("size %d: only %d hits and %d misses", size, c.Hits(), c.Misses())
+		}
+	}
+}
+
+func TestCachedConcurrent(t *testing.T) {
+	st := stem.Porter2English{}
+	c := stem.Cached(st, 1000)
+	words := zipf(englishVocabulary(t), 20000)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			buf := make([]byte, 0, 64)
+			for i := g; i < len(words); i += 8 {
+				buf = c.AppendStem(buf[:0], words[i])
+				if want := st.StemString(string(words[i])); string(buf) != want {
+					t.Errorf("stem of %q is %q; want %q", words[i], buf, want)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestCachedAllocs(t *testing.T) {
+	c := stem.Cached(stem.Porter2English{}, 10)
+	src := []byte("communication")
+	b := make([]byte, 0, 64)
+	c.StemString("communication")
+	allocs := testing.AllocsPerRun(100, func() {
+		b = c.StemBytes(append(b[:0], src...))
+		b = c.AppendStem(b[:0], src)
+	})
+	if allocs != 0 {
+		t.Errorf("hits allocate %v times", allocs)
+	}
+}
+
+func benchmarkZipf(b *testing.B, st stem.Interface) {
+	words := zipf(englishVocabulary(b), 100000)
+	buf := make([]byte, 0, 64)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = st.AppendStem(buf[:0], words[i%len(words)])
+	}
+}
+
+func BenchmarkUncached(b *testing.B) {
+	benchmarkZipf(b, stem.Porter2English{})
+}
+
+func BenchmarkCached(b *testing.B) {
+	benchmarkZipf(b, stem.Cached(stem.Porter2English{}, 4096))
+}
+
+func BenchmarkCachedParallel(b *testing.B) {
+	c := stem.Cached(stem.Porter2English{}, 4096)
+	words := zipf(englishVocabulary(b), 100000)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		buf := make([]byte, 0, 64)
+		for i := 0; pb.Next(); i++ {
+			buf = c.AppendStem(buf[:0], words[i%len(words)])
+		}
+	})
+}
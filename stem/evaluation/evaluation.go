@@ -0,0 +1,181 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Package evaluation measures how well stemmers conflate words.
+//
+// Paice computes the understemming and overstemming indices of Chris D.
+// Paice, "An evaluation method for stemming algorithms" (SIGIR 1994),
+// from a gold file of words grouped by concept. Compress reports how
+// much a stemmer reduces a vocabulary.
+package evaluation // import "xojoc.pw/nlp/stem/evaluation"
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"xojoc.pw/nlp/stem"
+)
+
+// Gold is a list of groups of words which should have the same stem,
+// like connect, connected and connection. A word belongs to a group
+// only.
+type Gold [][]string
+
+// ReadGold reads a gold file: each line is a group of white space
+// separated words. Empty lines and lines starting with # are skipped.
+func ReadGold(r io.Reader) (Gold, error) {
+	var g Gold
+	seen := map[string]int{}
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		group := strings.Fields(line)
+		for _, w := range group {
+			if l, ok := seen[w]; ok {
+				return nil, fmt.Errorf("evaluation: line %d: %q already in line %d", n, w, l)
+			}
+			seen[w] = n
+		}
+		g = append(g, group)
+	}
+	return g, sc.Err()
+}
+
+// PaiceResult holds Paice's indices and the totals they're computed
+// from.
+type PaiceResult struct {
+	// UI is the understemming index, UMT/DMT: the fraction of the
+	// pairs of words of the same group with different stems. It's 0
+	// if no group has two words.
+	UI float64
+	// OI is the overstemming index, WMT/DNT: the fraction of the
+	// pairs of words of different groups with the same stem. It's 0
+	// if there's only a group.
+	OI float64
+	// SW is the stemming weight, OI/UI: the higher, the heavier the
+	// stemmer. It's 0 if OI is 0 and +Inf if only UI is 0.
+	SW float64
+
+	// DMT is the desired merge total, the pairs of words of the same
+	// group.
+	DMT float64
+	// UMT is the unachieved merge total, the pairs of words of the
+	// same group with different stems.
+	UMT float64
+	// DNT is the desired non-merge total, the pairs of words of
+	// different groups.
+	DNT float64
+	// WMT is the wrongly merged total, the pairs of words of different
+	// groups with the same stem.
+	WMT float64
+}
+
+// Paice normalizes and stems the words of g with st and computes
+// Paice's indices.
+func Paice(st stem.Interface, g Gold) PaiceResult {
+	var r PaiceResult
+	words := 0
+	for _, group := range g {
+		words += len(group)
+	}
+	// classes counts the words of each group with a stem
+	classes := map[string]map[int]int{}
+	for i, group := range g {
+		n := float64(len(group))
+		r.DMT += n * (n - 1) / 2
+		r.DNT += n * float64(words-len(group)) / 2
+		stems := map[string]int{}
+		for _, w := range group {
+			s := st.StemString(st.NormalizeString(w))
+			stems[s]++
+			if classes[s] == nil {
+				classes[s] = map[int]int{}
+			}
+			classes[s][i]++
+		}
+		for _, u := range stems {
+			r.UMT += float64(u) * (n - float64(u)) / 2
+		}
+	}
+	for _, groups := range classes {
+		n := 0
+		for _, v := range groups {
+			n += v
+		}
+		for _, v := range groups {
+			r.WMT += float64(v) * float64(n-v) / 2
+		}
+	}
+	if r.DMT > 0 {
+		r.UI = r.UMT / r.DMT
+	}
+	if r.DNT > 0 {
+		r.OI = r.WMT / r.DNT
+	}
+	if r.OI > 0 {
+		r.SW = r.OI / r.UI
+	}
+	return r
+}
+
+// Compression tells how much a stemmer reduces a vocabulary.
+type Compression struct {
+	// Words and Stems are the number of distinct words and stems.
+	Words, Stems int
+}
+
+// Factor returns the index compression factor, (Words-Stems)/Words: the
+// fraction of the vocabulary removed by stemming.
+func (c Compression) Factor() float64 {
+	if c.Words == 0 {
+		return 0
+	}
+	return float64(c.Words-c.Stems) / float64(c.Words)
+}
+
+// MeanClassSize returns the average number of words with the same stem.
+func (c Compression) MeanClassSize() float64 {
+	if c.Stems == 0 {
+		return 0
+	}
+	return float64(c.Words) / float64(c.Stems)
+}
+
+// Compress reads a vocabulary with a word per line, ignoring anything
+// after the first field like the stems of the testfiles/vocabulary.txt
+// files, and counts its distinct words and stems.
+func Compress(st stem.Interface, r io.Reader) (Compression, error) {
+	words := map[string]bool{}
+	stems := map[string]bool{}
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		w := st.NormalizeString(fields[0])
+		if words[w] {
+			continue
+		}
+		words[w] = true
+		stems[st.StemString(w)] = true
+	}
+	return Compression{Words: len(words), Stems: len(stems)}, sc.Err()
+}
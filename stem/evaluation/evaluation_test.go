@@ -0,0 +1,163 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package evaluation_test
+
+import (
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"xojoc.pw/nlp/stem"
+	"xojoc.pw/nlp/stem/evaluation"
+)
+
+// truncate stems words to their first n bytes.
+type truncate int
+
+func (t truncate) StemBytes(b []byte) []byte {
+	if len(b) > int(t) {
+		b = b[:t]
+	}
+	return b
+}
+func (t truncate) AppendStem(dst, src []byte) []byte {
+	return append(dst, t.StemBytes(src)...)
+}
+func (t truncate) StemString(s string) string {
+	return string(t.StemBytes([]byte(s)))
+}
+func (truncate) NormalizeBytes(b []byte) []byte  { return b }
+func (truncate) NormalizeString(s string) string { return s }
+
+func gold(t *testing.T) evaluation.Gold {
+	f, err := os.Open("testfiles/groups.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	g, err := evaluation.ReadGold(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestReadGold(t *testing.T) {
+	g, err := evaluation.ReadGold(strings.NewReader("# comment\nabc1 abc2\n\n  abx\taby  \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g) != 2 || strings.Join(g[0], " ") != "abc1 abc2" || strings.Join(g[1], " ") != "abx aby" {
+		t.Errorf("got %q", g)
+	}
+	_, err = evaluation.ReadGold(strings.NewReader("a b\nc a\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("duplicate word: got error %v", err)
+	}
+}
+
+func TestPaice(t *testing.T) {
+	g := evaluation.Gold{{"abc1", "abc2", "abd3"}, {"abx", "aby"}}
+	tests := []struct {
+		n    int
+		want evaluation.PaiceResult
+	}{
+		{3, evaluation.PaiceResult{UI: 0.75, OI: 0, SW: 0, DMT: 4, UMT: 3, DNT: 6, WMT: 0}},
+		{2, evaluation.PaiceResult{UI: 0, OI: 1, SW: math.Inf(1), DMT: 4, UMT: 0, DNT: 6, WMT: 6}},
+		{4, evaluation.PaiceResult{UI: 1, OI: 0, SW: 0, DMT: 4, UMT: 4, DNT: 6, WMT: 0}},
+	}
+	for _, test := range tests {
+		got := evaluation.Paice(truncate(test.n), g)
+		if got != test.want {
+			t.Errorf("truncate(%d): got %+v, want %+v", test.n, got, test.want)
+		}
+	}
+}
+
+func TestPaiceDegenerate(t *testing.T) {
+	tests := []struct {
+		g    evaluation.Gold
+		n    int
+		want evaluation.PaiceResult
+	}{
+		{nil, 2, evaluation.PaiceResult{}},
+		// no pairs to merge
+		{evaluation.Gold{{"abc"}, {"abx"}}, 2, evaluation.PaiceResult{UI: 0, OI: 1, SW: math.Inf(1), DNT: 1, WMT: 1}},
+		{evaluation.Gold{{"abc"}, {"abx"}}, 3, evaluation.PaiceResult{DNT: 1}},
+		// no pairs to keep apart
+		{evaluation.Gold{{"abc1", "abc2"}}, 3, evaluation.PaiceResult{DMT: 1}},
+		{evaluation.Gold{{"abc1", "abc2"}}, 4, evaluation.PaiceResult{UI: 1, DMT: 1, UMT: 1}},
+	}
+	for _, test := range tests {
+		got := evaluation.Paice(truncate(test.n), test.g)
+		if got != test.want {
+			t.Errorf("%q truncate(%d): got %+v, want %+v", test.g, test.n, got, test.want)
+		}
+	}
+}
+
+func TestPaiceStemmers(t *testing.T) {
+	g := gold(t)
+	porter2 := evaluation.Paice(stem.Porter2English{}, g)
+	lovins := evaluation.Paice(stem.Lovins{}, g)
+	t.Logf("porter2: %+v", porter2)
+	t.Logf("lovins: %+v", lovins)
+	for _, r := range []evaluation.PaiceResult{porter2, lovins} {
+		if r.UI <= 0 || r.UI >= 1 || r.OI <= 0 || r.OI >= 1 {
+			t.Errorf("indices out of range: %+v", r)
+		}
+	}
+	// Lovins is a heavier stemmer than Porter2.
+	if lovins.SW <= porter2.SW {
+		t.Errorf("Lovins SW %v <= Porter2 SW %v", lovins.SW, porter2.SW)
+	}
+}
+
+func TestCompress(t *testing.T) {
+	c, err := evaluation.Compress(truncate(3), strings.NewReader("abc1 abc\nabc2\n\nabc1 x\nabd\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != (evaluation.Compression{Words: 3, Stems: 2}) {
+		t.Errorf("got %+v", c)
+	}
+	if f := c.Factor(); math.Abs(f-1.0/3) > 1e-9 {
+		t.Errorf("Factor: got %v", f)
+	}
+	if m := c.MeanClassSize(); m != 1.5 {
+		t.Errorf("MeanClassSize: got %v", m)
+	}
+	if (evaluation.Compression{}).Factor() != 0 || (evaluation.Compression{}).MeanClassSize() != 0 {
+		t.Error("empty vocabulary")
+	}
+}
+
+func TestCompressVocabulary(t *testing.T) {
+	f, err := os.Open("../internal/porter2english/testfiles/vocabulary.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	c, err := evaluation.Compress(stem.Porter2English{}, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%+v factor %.3f", c, c.Factor())
+	if c.Words < 20000 || c.Stems >= c.Words || c.Factor() < 0.2 {
+		t.Errorf("got %+v", c)
+	}
+}
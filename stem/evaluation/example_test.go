@@ -0,0 +1,53 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package evaluation_test
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"xojoc.pw/nlp/stem"
+	"xojoc.pw/nlp/stem/evaluation"
+)
+
+func ExamplePaice() {
+	g, err := evaluation.ReadGold(strings.NewReader(`
+connect connected connection
+general generally generalization
+generate generated generation
+`))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, st := range []stem.Interface{stem.Porter2English{}, stem.Lovins{}} {
+		r := evaluation.Paice(st, g)
+		fmt.Printf("%T UI=%.2f OI=%.2f\n", st, r.UI, r.OI)
+	}
+	//Output:
+	// stem.Porter2English UI=0.00 OI=0.00
+	// stem.Lovins UI=0.22 OI=0.22
+}
+
+func ExampleCompress() {
+	vocabulary := "run\nruns\nrunning\nrunner\njump\njumped\n"
+	c, err := evaluation.Compress(stem.Porter2English{}, strings.NewReader(vocabulary))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%d words, %d stems, factor %.2f\n", c.Words, c.Stems, c.Factor())
+	//Output: 6 words, 3 stems, factor 0.50
+}
@@ -17,8 +17,11 @@ package stem_test
 
 import (
 	"fmt"
+	"log"
+	"strings"
 
-	"gitlab.com/gonlp/stem"
+	"xojoc.pw/nlp/stem"
+	"xojoc.pw/nlp/stem/porter2"
 )
 
 func Example() {
@@ -41,6 +44,18 @@ func ExamplePorter2English_StemBytes() {
 	fmt.Printf("%s", b)
 	//Output: run
 }
+func ExamplePorter2English_AppendStem() {
+	st := stem.Porter2English{}
+	var buf []byte
+	for _, w := range []string{"running", "jumped"} {
+		// AppendStem leaves the source untouched and reuses buf.
+		buf = st.AppendStem(buf[:0], []byte(w))
+		fmt.Printf("%s\n", buf)
+	}
+	//Output:
+	// run
+	// jump
+}
 func ExamplePorter2English_StemString() {
 	st := stem.Porter2English{}
 	fmt.Println(st.StemString("enjoying"))
@@ -58,3 +73,127 @@ func ExamplePorter2English_NormalizeBytes() {
 	//Output: normalized
 	// normal
 }
+
+func ExampleNewLancaster() {
+	rules := `
+gni3>  { -ing > - }
+nn1.   { -nn > -n }
+end0.`
+	st, err := stem.NewLancaster(strings.NewReader(rules))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(st.StemString("running"), st.StemString("happiness"))
+	//Output: run happiness
+}
+
+func ExampleNewSnowball() {
+	program := `
+externals ( stem )
+define stem as backwards (
+    [substring] among ( 'ies' (<- 'y') 'es' 's' (delete) )
+)`
+	st, err := stem.NewSnowball(strings.NewReader(program))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(st.StemString("ponies"), st.StemString("cats"))
+	//Output: pony cat
+}
+
+func ExampleFoldDiacritics() {
+	st := stem.FoldDiacritics(stem.Porter2Italian{})
+	for _, w := range []string{"Perché", "perche"} {
+		fmt.Println(st.StemString(st.NormalizeString(w)))
+	}
+	//Output:
+	// perc
+	// perc
+}
+
+func ExampleStopwords() {
+	sw, err := stem.Stopwords("en")
+	if err != nil {
+		log.Fatal(err)
+	}
+	sw.Load(strings.NewReader("-not\nplease"))
+	for _, w := range strings.Fields("please do not stop") {
+		fmt.Println(w, sw.ContainsString(w))
+	}
+	//Output:
+	// please true
+	// do true
+	// not false
+	// stop false
+}
+
+func ExampleExceptions() {
+	st := stem.NewExceptions(stem.Porter2English{})
+	st.Add("mice", "mouse")
+	st.Protect("Kubernetes", "news")
+	for _, w := range []string{"mice", "kubernetes", "news", "running"} {
+		fmt.Println(st.StemString(w))
+	}
+	//Output:
+	// mouse
+	// kubernetes
+	// news
+	// run
+}
+
+func ExampleCached() {
+	st := stem.Cached(stem.Porter2English{}, 1000)
+	for _, w := range strings.Fields("the cat sat on the mat with the other cats") {
+		st.StemString(w)
+	}
+	fmt.Println(st.Hits(), st.Misses())
+	//Output: 2 8
+}
+
+func ExampleUnstemmer() {
+	u := stem.NewUnstemmer(stem.Porter2English{})
+	for _, w := range strings.Fields("generous generals generally generate general generalizations generally") {
+		u.Add(w)
+	}
+	fmt.Println(u.Best("general"))
+	fmt.Println(u.Forms("generous"))
+	//Output:
+	// generally true
+	// [{generous 1}]
+}
+
+func ExampleForLanguage() {
+	st, err := stem.ForLanguage("it-IT")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(st.StemString("abbandonata"))
+	//Output: abbandon
+}
+
+func ExampleSteps() {
+	r1 := porter2.R1("aeiouy")
+	plural := porter2.MustNewStep([]porter2.Suffix{
+		{Suffixes: "ies", Callback: porter2.Replace("y")},
+	})
+	medical := porter2.MustNewStep([]porter2.Suffix{
+		{Suffixes: "ectomy itis", Callback: porter2.Delete, MatchRegion: r1},
+	})
+	st := stem.Steps{plural, medical}
+	for _, w := range []string{"tonsillectomies", "tonsillectomy", "tonsillitis"} {
+		fmt.Println(st.StemString(w))
+	}
+	//Output:
+	// tonsill
+	// tonsill
+	// tonsill
+}
+
+func ExampleExplain() {
+	for _, tr := range stem.Explain(stem.Porter2English{}, "communication") {
+		fmt.Println(tr.Step, tr.Suffix, tr.Region, tr.Word)
+	}
+	//Output:
+	// step2 ation R1 communicate
+	// step3 icate R1 communic
+}
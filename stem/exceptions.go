@@ -0,0 +1,127 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Exceptions is a stemmer with a dictionary of user exceptions, looked
+// up before the algorithm of the wrapped stemmer: words can be forced to
+// a stem, like mice to mouse, or protected from stemming, like product
+// names. Lookups of words not in the dictionary don't allocate.
+type Exceptions struct {
+	st Interface
+	// stems maps words to their stems; protected words map to
+	// themselves.
+	stems map[string]string
+}
+
+var _ Interface = (*Exceptions)(nil)
+
+// NewExceptions returns a stemmer with an empty dictionary which falls
+// back to st.
+func NewExceptions(st Interface) *Exceptions {
+	return &Exceptions{st: st, stems: map[string]string{}}
+}
+
+// Add makes stem the stem of word. Both are normalized with the
+// wrapped stemmer.
+func (e *Exceptions) Add(word, stem string) {
+	e.stems[e.st.NormalizeString(word)] = e.st.NormalizeString(stem)
+}
+
+// Protect keeps words as they are.
+func (e *Exceptions) Protect(words ...string) {
+	for _, w := range words {
+		w = e.st.NormalizeString(w)
+		e.stems[w] = w
+	}
+}
+
+// Load reads the dictionary from tab separated lines: a word and its
+// stem or only a word to protect it. Words can't contain spaces. Empty
+// lines and lines starting with # are skipped:
+//
+//	# irregular plurals
+//	mice	mouse
+//	kubernetes
+func (e *Exceptions) Load(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		for i, f := range fields {
+			fields[i] = strings.TrimSpace(f)
+			if strings.IndexFunc(fields[i], unicode.IsSpace) >= 0 {
+				return fmt.Errorf("stem: exceptions line %d: %q isn't a single word", n, fields[i])
+			}
+		}
+		switch {
+		case len(fields) == 1:
+			e.Protect(fields[0])
+		case len(fields) == 2 && fields[1] != "":
+			e.Add(fields[0], fields[1])
+		default:
+			return fmt.Errorf("stem: exceptions line %d: want a word and optionally its stem, separated by a tab", n)
+		}
+	}
+	return sc.Err()
+}
+
+func (e *Exceptions) StemBytes(b []byte) []byte {
+	if s, ok := e.stems[string(b)]; ok {
+		return append(b[:0], s...)
+	}
+	return e.st.StemBytes(b)
+}
+func (e *Exceptions) AppendStem(dst, src []byte) []byte {
+	if s, ok := e.stems[string(src)]; ok {
+		return append(dst, s...)
+	}
+	return e.st.AppendStem(dst, src)
+}
+func (e *Exceptions) StemString(s string) string {
+	if st, ok := e.stems[s]; ok {
+		return st
+	}
+	return e.st.StemString(s)
+}
+func (e *Exceptions) NormalizeBytes(b []byte) []byte {
+	return e.st.NormalizeBytes(b)
+}
+func (e *Exceptions) NormalizeString(s string) string {
+	return e.st.NormalizeString(s)
+}
+
+// Explain reports exceptions as a single step named "exception".
+// Protected words have no steps.
+func (e *Exceptions) Explain(s string) []Trace {
+	if st, ok := e.stems[s]; ok {
+		if st == s {
+			return nil
+		}
+		return []Trace{{Step: "exception", Word: st}}
+	}
+	return Explain(e.st, s)
+}
@@ -0,0 +1,99 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem_test
+
+import (
+	"strings"
+	"testing"
+
+	"xojoc.pw/nlp/stem"
+)
+
+const exceptionsTSV = `# irregular plurals
+Mice	mouse
+geese	goose
+
+# product names
+Kubernetes
+news
+`
+
+func newExceptions(t testing.TB) *stem.Exceptions {
+	e := stem.NewExceptions(stem.Porter2English{})
+	if err := e.Load(strings.NewReader(exceptionsTSV)); err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestExceptions(t *testing.T) {
+	e := newExceptions(t)
+	for in, out := range map[string]string{
+		"mice":       "mouse",
+		"geese":      "goose",
+		"kubernetes": "kubernetes",
+		"news":       "news",
+		"running":    "run",
+	} {
+		if got := e.StemString(in); got != out {
+			t.Errorf("StemString(%q) = %q; want %q", in, got, out)
+		}
+		if got := string(e.StemBytes([]byte(in))); got != out {
+			t.Errorf("StemBytes(%q) = %q; want %q", in, got, out)
+		}
+		if got := string(e.AppendStem([]byte("> "), []byte(in))); got != "> "+out {
+			t.Errorf("AppendStem(%q) = %q; want %q", in, got, "> "+out)
+		}
+	}
+	if tr := stem.Explain(e, "mice"); len(tr) != 1 || tr[0].Step != "exception" || tr[0].Word != "mouse" {
+		t.Errorf("Explain(mice) = %v", tr)
+	}
+	if tr := stem.Explain(e, "news"); tr != nil {
+		t.Errorf("Explain(news) = %v", tr)
+	}
+	if tr := stem.Explain(e, "running"); len(tr) == 0 || tr[len(tr)-1].Word != "run" {
+		t.Errorf("Explain(running) = %v", tr)
+	}
+}
+
+func TestExceptionsLoadError(t *testing.T) {
+	for _, text := range []string{
+		"mice\tmouse\n\nfoo\tbar\tbaz\n",
+		"mice\tmouse\n\nmice mouse\n",
+		"mice\tmouse\n# phrases\nnew york\tyork\n",
+		"mice\tmouse\n\nfeet\tfoot of\n",
+	} {
+		e := stem.NewExceptions(stem.Porter2English{})
+		err := e.Load(strings.NewReader(text))
+		if err == nil || !strings.Contains(err.Error(), "line 3") {
+			t.Errorf("Load(%q) error = %v; want an error at line 3", text, err)
+		}
+	}
+}
+
+func TestExceptionsAllocs(t *testing.T) {
+	e := newExceptions(t)
+	src := []byte("communication")
+	b := make([]byte, 0, 64)
+	dst := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		b = e.StemBytes(append(b[:0], src...))
+		dst = e.AppendStem(dst[:0], src)
+	})
+	if allocs != 0 {
+		t.Errorf("lookup misses allocate %v times", allocs)
+	}
+}
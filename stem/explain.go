@@ -0,0 +1,46 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem
+
+import "xojoc.pw/nlp/stem/porter2"
+
+// Trace describes a step of a stemmer that changed a word: the name of the
+// step, the suffix it matched and its region, if known, and the word after
+// the step. A step may be described by several Traces, one for each
+// suffix it matched.
+type Trace = porter2.Trace
+
+// Explainer is implemented by the stemmers that can tell which of their
+// steps changed a word.
+type Explainer interface {
+	// Explain stems s and returns the steps that changed it, in order.
+	Explain(s string) []Trace
+}
+
+// Explain stems word with st and returns the steps that changed it, in
+// order. The Word of the last Trace is the stem. Like StemString, Explain
+// assumes word is already normalized. If st isn't an Explainer the whole
+// stemmer is reported as a single step named "stem".
+func Explain(st Interface, word string) []Trace {
+	if e, ok := st.(Explainer); ok {
+		return e.Explain(word)
+	}
+	s := st.StemString(word)
+	if s == word {
+		return nil
+	}
+	return []Trace{{Step: "stem", Word: s}}
+}
@@ -0,0 +1,105 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem_test
+
+import (
+	"bufio"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"xojoc.pw/nlp/stem"
+)
+
+func TestExplain(t *testing.T) {
+	traces := stem.Explain(stem.Porter2English{}, "communication")
+	want := []stem.Trace{
+		{Step: "step2", Suffix: "ation", Region: "R1", Word: "communicate"},
+		{Step: "step3", Suffix: "icate", Region: "R1", Word: "communic"},
+	}
+	if !reflect.DeepEqual(traces, want) {
+		t.Errorf("Explain(communication) = %+v; want %+v", traces, want)
+	}
+	// The nested steps of a suffix are recorded, with the form they produced.
+	traces = stem.Explain(stem.Porter2French{}, "majestueusement")
+	want = []stem.Trace{
+		{Step: "step1", Suffix: "ement", Region: "RV", Word: "majestueus"},
+		{Step: "step1", Suffix: "eus", Word: "majestu"},
+	}
+	if !reflect.DeepEqual(traces, want) {
+		t.Errorf("Explain(majestueusement) = %+v; want %+v", traces, want)
+	}
+	if traces := stem.Explain(stem.Porter2English{}, "run"); len(traces) != 0 {
+		t.Errorf("Explain(run) = %+v; want no traces", traces)
+	}
+	traces = stem.Explain(stem.Snowball{}, "running")
+	if len(traces) != 0 {
+		t.Errorf("Explain with the zero Snowball = %+v; want no traces", traces)
+	}
+}
+
+// TestExplainStem checks that the last Trace is always the stem.
+func TestExplainStem(t *testing.T) {
+	for dir, st := range map[string]stem.Interface{
+		"porter2danish":     stem.Porter2Danish{},
+		"porter2dutch":      stem.Porter2Dutch{},
+		"porter2english":    stem.Porter2English{},
+		"porter2finnish":    stem.Porter2Finnish{},
+		"porter2french":     stem.Porter2French{},
+		"porter2german":     stem.Porter2German{},
+		"porter2hungarian":  stem.Porter2Hungarian{},
+		"porter2italian":    stem.Porter2Italian{},
+		"porter2norwegian":  stem.Porter2Norwegian{},
+		"porter2portuguese": stem.Porter2Portuguese{},
+		"porter2russian":    stem.Porter2Russian{},
+		"porter2spanish":    stem.Porter2Spanish{},
+		"porter2swedish":    stem.Porter2Swedish{},
+		"porterenglish":     stem.PorterEnglish{},
+		"kraaijpohlmann":    stem.KraaijPohlmann{},
+		"lancaster":         stem.Lancaster{},
+		"lovins":            stem.Lovins{},
+	} {
+		if _, ok := st.(stem.Explainer); !ok {
+			t.Errorf("%T isn't an Explainer", st)
+			continue
+		}
+		f, err := os.Open("internal/" + dir + "/testfiles/vocabulary.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) == 0 {
+				continue
+			}
+			w := fields[0]
+			want := st.StemString(w)
+			got := w
+			if traces := stem.Explain(st, w); len(traces) > 0 {
+				got = traces[len(traces)-1].Word
+			}
+			if got != want {
+				t.Errorf("%T: Explain(%q) ends with %q, want %q", st, w, got, want)
+			}
+		}
+		f.Close()
+		if err := scanner.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem
+
+import "xojoc.pw/nlp/stem/internal/textnorm"
+
+// FoldDiacritics returns a stemmer that removes the diacritics from the
+// stems of st, for accent-insensitive search: città and citta end up
+// with the same stem. Diacritics are removed after stemming, so the
+// suffixes of st still match. Normalization is left to st.
+func FoldDiacritics(st Interface) Interface {
+	return diacriticFolder{st}
+}
+
+type diacriticFolder struct {
+	st Interface
+}
+
+func (d diacriticFolder) StemBytes(b []byte) []byte {
+	return textnorm.FoldDiacritics(d.st.StemBytes(b))
+}
+func (d diacriticFolder) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, d.StemBytes)
+}
+func (d diacriticFolder) StemString(s string) string {
+	return string(d.StemBytes([]byte(s)))
+}
+func (d diacriticFolder) NormalizeBytes(b []byte) []byte {
+	return d.st.NormalizeBytes(b)
+}
+func (d diacriticFolder) NormalizeString(s string) string {
+	return d.st.NormalizeString(s)
+}
+
+// Explain reports the removal of the diacritics as a final step named
+// "foldDiacritics".
+func (d diacriticFolder) Explain(s string) []Trace {
+	t := Explain(d.st, s)
+	stem := s
+	if len(t) > 0 {
+		stem = t[len(t)-1].Word
+	}
+	if f := string(textnorm.FoldDiacritics([]byte(stem))); f != stem {
+		t = append(t, Trace{Step: "foldDiacritics", Word: f})
+	}
+	return t
+}
@@ -0,0 +1,508 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Package kraaijpohlmann implements the Kraaij-Pohlmann stemmer for Dutch.
+package kraaijpohlmann
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/kraaij_pohlmann/stemmer.html
+
+const vowels = "aeiouy"
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune(vowels, r)
+}
+
+func isAny(b byte, s string) bool {
+	return strings.IndexByte(s, b) >= 0
+}
+
+// region returns the region after the first non-vowel following a vowel.
+// Unlike porter2.R1 the digraph ij counts as a vowel.
+func region(s []byte) []byte {
+	for {
+		r, l := utf8.DecodeRune(s)
+		if l == 0 || isVowel(r) {
+			break
+		}
+		s = s[l:]
+	}
+	if len(s) == 0 {
+		return s
+	}
+	for {
+		if bytes.HasPrefix(s, []byte("ij")) {
+			s = s[2:]
+			continue
+		}
+		r, l := utf8.DecodeRune(s)
+		if l == 0 || !isVowel(r) {
+			break
+		}
+		s = s[l:]
+	}
+	_, l := utf8.DecodeRune(s)
+	return s[l:]
+}
+
+func r1(s []byte) []byte {
+	return region(s)
+}
+
+func r2(s []byte) []byte {
+	return region(region(s))
+}
+
+// inR1 reports whether the last n bytes of s are inside R1.
+func inR1(s []byte, n int) bool {
+	return len(r1(s)) >= n
+}
+
+func inR2(s []byte, n int) bool {
+	return len(r2(s)) >= n
+}
+
+// c reports whether s ends with a consonant which is not part of ij.
+func c(s []byte) bool {
+	r, l := utf8.DecodeLastRune(s)
+	return l > 0 && !isVowel(r) && !bytes.HasSuffix(s, []byte("ij"))
+}
+
+// v reports whether s ends with a vowel or ij.
+func v(s []byte) bool {
+	r, l := utf8.DecodeLastRune(s)
+	return l > 0 && isVowel(r) || bytes.HasSuffix(s, []byte("ij"))
+}
+
+// vx is like v but skips the last letter of s.
+func vx(s []byte) bool {
+	_, l := utf8.DecodeLastRune(s)
+	return l > 0 && v(s[:len(s)-l])
+}
+
+// lengthenV doubles the vowel of a final vowel-consonant pair,
+// e.g. mak -> maak.
+func lengthenV(s []byte) []byte {
+	n := len(s)
+	if n < 2 {
+		return s
+	}
+	last, vw := s[n-1], s[n-2]
+	if isAny(last, vowels+"wx") {
+		return s
+	}
+	i := n - 2
+	if i > 0 && isAny(s[i-1], vowels) {
+		return s
+	}
+	switch {
+	case isAny(vw, "aou"):
+	case vw == 'e':
+		k := 0
+		if i > 0 {
+			k = i - 1
+		}
+		if k > 0 && isAny(s[k-1], "aiou") {
+			return s
+		}
+		if k >= 3 && isAny(s[k-2], "aiou") && !isAny(s[k-3], vowels) {
+			return s
+		}
+	default:
+		return s
+	}
+	s = append(s, last)
+	s[n-1] = vw
+	return s
+}
+
+func with(m []byte, tail string) []byte {
+	return append(m, tail...)
+}
+
+func step1S(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	if inR1(s, 1) && !(bytes.HasSuffix(m, []byte("t")) && inR1(s, 2)) && c(m) {
+		return m
+	}
+	return nil
+}
+
+func step1Es(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	switch {
+	case bytes.HasSuffix(m, []byte("ar")) && inR1(s, 4) && c(m[:len(m)-2]):
+		return lengthenV(m[:len(m)-2])
+	case bytes.HasSuffix(m, []byte("er")) && inR1(s, 4) && c(m[:len(m)-2]):
+		return m[:len(m)-2]
+	case inR1(s, 2) && c(m):
+		return with(m, "e")
+	}
+	return nil
+}
+
+func step1Aus(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	if inR1(s, 3) && v(m) {
+		return with(m, "au")
+	}
+	return nil
+}
+
+func step1En(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	switch {
+	case bytes.HasSuffix(m, []byte("hed")) && inR1(s, 5):
+		return with(m[:len(m)-3], "heid")
+	case bytes.HasSuffix(m, []byte("nd")):
+		return m
+	case bytes.HasSuffix(m, []byte("d")) && inR1(s, 3) && c(m[:len(m)-1]):
+		return m[:len(m)-1]
+	case (bytes.HasSuffix(m, []byte("i")) || bytes.HasSuffix(m, []byte("j"))) && v(m[:len(m)-1]):
+		return m
+	case inR1(s, 2) && c(m):
+		return lengthenV(m)
+	}
+	return nil
+}
+
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "'s", Callback: Delete},
+	{Suffixes: "s", Callback: step1S},
+	{Suffixes: "ies", Callback: Replace("ie"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "es", Callback: step1Es},
+	{Suffixes: "aus", Callback: step1Aus},
+	{Suffixes: "en", Callback: step1En},
+	{Suffixes: "nde", Callback: Truncate("nd")},
+})
+
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
+}
+
+func step2Je(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	switch {
+	case bytes.HasSuffix(m, []byte("'t")):
+		return m[:len(m)-2]
+	case bytes.HasSuffix(m, []byte("et")) && inR1(s, 4) && c(m[:len(m)-2]):
+		return m[:len(m)-2]
+	case bytes.HasSuffix(m, []byte("rnt")):
+		return m[:len(m)-1]
+	case bytes.HasSuffix(m, []byte("t")) && inR1(s, 3) && vx(m[:len(m)-1]):
+		return m[:len(m)-1]
+	case bytes.HasSuffix(m, []byte("ink")):
+		return with(m[:len(m)-1], "g")
+	case bytes.HasSuffix(m, []byte("mp")):
+		return m[:len(m)-1]
+	case bytes.HasSuffix(m, []byte("'")) && inR1(s, 3):
+		return m[:len(m)-1]
+	case inR1(s, 2) && c(m):
+		return m
+	}
+	return nil
+}
+
+// deleteC deletes suffix if it is in R1 and preceded by a consonant.
+func deleteC(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	if inR1(s, len(suffix)) && c(m) {
+		return m
+	}
+	return nil
+}
+
+// lengthen replaces suffix with by and then lengthens the vowel.
+func lengthen(by string) func([]byte, []byte) []byte {
+	replace := Replace(by)
+	return func(s []byte, suffix []byte) []byte {
+		return lengthenV(replace(s, suffix))
+	}
+}
+
+func step2Ene(s []byte, suffix []byte) []byte {
+	if c(s[:len(s)-len(suffix)]) {
+		return lengthen("en")(s, suffix)
+	}
+	return nil
+}
+
+func step2Ieve(s []byte, suffix []byte) []byte {
+	if c(s[:len(s)-len(suffix)]) {
+		return Replace("ief")(s, suffix)
+	}
+	return nil
+}
+
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "je", Callback: step2Je},
+	{Suffixes: "ge", Callback: Truncate("g"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "lijke", Callback: Truncate("lijk"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ische", Callback: Truncate("isch"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "de", Callback: deleteC},
+	{Suffixes: "te", Callback: Truncate("t"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "se", Callback: Truncate("s"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "re", Callback: Truncate("r"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "le", Callback: lengthen("l"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ene", Callback: step2Ene, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ieve", Callback: step2Ieve, ActionRegion: r1, Region: "R1"},
+})
+
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
+}
+
+func replaceC(by string) func([]byte, []byte) []byte {
+	replace := Replace(by)
+	return func(s []byte, suffix []byte) []byte {
+		if c(s[:len(s)-len(suffix)]) {
+			return replace(s, suffix)
+		}
+		return nil
+	}
+}
+
+var step3Step = MustNewStep([]Suffix{
+	{Suffixes: "atie", Callback: Replace("eer"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "iteit", Callback: lengthen(""), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "heid sel ster", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "rster", Callback: Truncate("r"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ken", Callback: lengthen("k"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "der", Callback: lengthen("r"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "isme erij", Callback: lengthen(""), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "arij", Callback: replaceC("aar"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "fie", Callback: lengthen("f"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "gie", Callback: lengthen("g"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "tst", Callback: replaceC("t"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "dst", Callback: replaceC("d"), ActionRegion: r1, Region: "R1"},
+})
+
+func step3(s []byte, t *Tracer) []byte {
+	return t.Hook(step3Step).Apply(s)
+}
+
+func afterV(by string) func([]byte, []byte) []byte {
+	replace := Replace(by)
+	return func(s []byte, suffix []byte) []byte {
+		if v(s[:len(s)-len(suffix)]) {
+			return replace(s, suffix)
+		}
+		return nil
+	}
+}
+
+func deleteCLengthen(s []byte, suffix []byte) []byte {
+	if c(s[:len(s)-len(suffix)]) {
+		return lengthenV(Delete(s, suffix))
+	}
+	return nil
+}
+
+var step4aStep = MustNewStep([]Suffix{
+	{Suffixes: "ioneel", Callback: Replace("ie"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "atief", Callback: Replace("eer"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "baar", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "naar", Callback: afterV("n"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "laar", Callback: afterV("l"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "raar", Callback: afterV("r"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "tant", Callback: Replace("teer"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "lijker lijkst", Callback: Replace("lijk"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "achtig achtiger achtigst", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "eriger erigst erig end", Callback: deleteCLengthen, ActionRegion: r1, Region: "R1"},
+})
+
+var step4bStep = MustNewStep([]Suffix{
+	{Suffixes: "iger igst ig", Callback: deleteCLengthen, ActionRegion: r1, Region: "R1"},
+})
+
+func step4(s []byte, t *Tracer) ([]byte, bool) {
+	s, ok := apply(step4aStep, s, t)
+	if ok {
+		return s, true
+	}
+	return apply(step4bStep, s, t)
+}
+
+var step7Step = MustNewStep([]Suffix{
+	{Suffixes: "kt", Callback: Truncate("k")},
+	{Suffixes: "ft", Callback: Truncate("f")},
+	{Suffixes: "pt", Callback: Truncate("p")},
+})
+
+func step7(s []byte, t *Tracer) []byte {
+	return t.Hook(step7Step).Apply(s)
+}
+
+var step6Step = MustNewStep([]Suffix{
+	{Suffixes: "bb cc dd ff gg hh jj kk ll mm nn pp qq rr ss tt vv ww xx zz", Callback: undouble},
+	{Suffixes: "v", Callback: Replace("f")},
+	{Suffixes: "z", Callback: Replace("s")},
+})
+
+func undouble(s []byte, suffix []byte) []byte {
+	return s[:len(s)-1]
+}
+
+func step6(s []byte, t *Tracer) []byte {
+	return t.Hook(step6Step).Apply(s)
+}
+
+// step1c removes the final d or t of a past participle, unless it
+// follows an n or h in R1.
+func step1c(s []byte) []byte {
+	if !bytes.HasSuffix(s, []byte("d")) && !bytes.HasSuffix(s, []byte("t")) {
+		return s
+	}
+	m := s[:len(s)-1]
+	if !inR1(s, 1) || !c(m) {
+		return s
+	}
+	if (bytes.HasSuffix(s, []byte("nd")) || bytes.HasSuffix(s, []byte("ht"))) && inR1(s, 2) {
+		return s
+	}
+	return m
+}
+
+// apply applies st to s and reports whether s was changed.
+func apply(st *Step, s []byte, t *Tracer) ([]byte, bool) {
+	var buf [64]byte
+	old := append(buf[:0], s...)
+	s = t.Hook(st).Apply(s)
+	return s, !bytes.Equal(old, s)
+}
+
+// hasRoom reports whether what follows a ge prefix or infix is at least
+// 3 letters long and contains a vowel followed by a non-vowel.
+func hasRoom(s []byte) bool {
+	if utf8.RuneCount(s) < 3 {
+		return false
+	}
+	i := bytes.IndexAny(s, vowels)
+	return i >= 0 && bytes.IndexFunc(s[i:], func(r rune) bool { return !isVowel(r) }) >= 0
+}
+
+func losePrefix(s []byte) ([]byte, bool) {
+	if !bytes.HasPrefix(s, []byte("ge")) || !hasRoom(s[2:]) {
+		return s, false
+	}
+	copy(s, s[2:])
+	return s[:len(s)-2], true
+}
+
+func loseInfix(s []byte) ([]byte, bool) {
+	_, l := utf8.DecodeRune(s)
+	if l == 0 {
+		return s, false
+	}
+	i := bytes.Index(s[l:], []byte("ge"))
+	if i < 0 {
+		return s, false
+	}
+	i += l
+	if !hasRoom(s[i+2:]) {
+		return s, false
+	}
+	copy(s[i:], s[i+2:])
+	return s[:len(s)-2], true
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	yFound := false
+	if len(s) > 0 && s[0] == 'y' {
+		s[0] = 'Y'
+		yFound = true
+	}
+	for i := 1; i < len(s); i++ {
+		if s[i] == 'y' && isAny(s[i-1], vowels) {
+			s[i] = 'Y'
+			yFound = true
+		}
+	}
+	t.Record("prelude", s)
+
+	s, ok1 := apply(step1Step, s, t)
+	t.Record("step1", s)
+	s, ok2 := apply(step2Step, s, t)
+	t.Record("step2", s)
+	s, ok3 := apply(step3Step, s, t)
+	t.Record("step3", s)
+	s, ok4 := step4(s, t)
+	t.Record("step4", s)
+	stemmed := ok1 || ok2 || ok3 || ok4
+
+	// Only the removal of an infix lets step6 undouble the stem.
+	geRemoved := false
+	if r, ok := losePrefix(s); ok {
+		t.Record("losePrefix", r)
+		s = step1c(r)
+		t.Record("step1c", s)
+	}
+	if r, ok := loseInfix(s); ok {
+		t.Record("loseInfix", r)
+		s = step1c(r)
+		t.Record("step1c", s)
+		geRemoved = true
+	}
+
+	s, ok := apply(step7Step, s, t)
+	t.Record("step7", s)
+	if ok || stemmed || geRemoved {
+		s = step6(s, t)
+		t.Record("step6", s)
+	}
+
+	if yFound {
+		for i := range s {
+			if s[i] == 'Y' {
+				s[i] = 'y'
+			}
+		}
+		t.Record("postlude", s)
+	}
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
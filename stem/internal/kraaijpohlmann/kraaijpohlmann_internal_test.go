@@ -0,0 +1,69 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package kraaijpohlmann
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestR1(t *testing.T) {
+	pairs := []string{"lichamelijk", "hamelijk", "vrijheid", "eid", "ijs", ""}
+	test(t, r1, pairs)
+}
+
+func TestLengthenV(t *testing.T) {
+	pairs := []string{"mak", "maak", "lev", "leev", "kop", "koop", "boek", "boek", "opbouw", "opbouw", "licht", "licht"}
+	test(t, lengthenV, pairs)
+}
+
+func TestStep1(t *testing.T) {
+	pairs := []string{"maken", "maak", "auto's", "auto", "lichtende", "lichtend"}
+	test(t, untraced(step1), pairs)
+}
+
+func TestStep2(t *testing.T) {
+	pairs := []string{"huisje", "huis", "boekje", "boek", "mooiste", "mooist"}
+	test(t, untraced(step2), pairs)
+}
+
+func TestStep6(t *testing.T) {
+	pairs := []string{"bidd", "bid", "leev", "leef", "huiz", "huis"}
+	test(t, untraced(step6), pairs)
+}
+
+func TestLosePrefix(t *testing.T) {
+	pairs := []string{"gemaakt", "maakt", "geel", "geel", "gebouwd", "bouwd"}
+	test(t, func(s []byte) []byte {
+		s, _ = losePrefix(s)
+		return s
+	}, pairs)
+}
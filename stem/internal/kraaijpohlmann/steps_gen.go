@@ -0,0 +1,1242 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package kraaijpohlmann
+
+import (
+	"bytes"
+)
+
+func init() {
+	step1Step.SetDo(step1StepDo)
+	step2Step.SetDo(step2StepDo)
+	step3Step.SetDo(step3StepDo)
+	step4aStep.SetDo(step4aStepDo)
+	step4bStep.SetDo(step4bStepDo)
+	step6Step.SetDo(step6StepDo)
+	step7Step.SetDo(step7StepDo)
+}
+
+var step1StepSuffixes = [...][]byte{
+	[]byte("en"),
+	[]byte("ies"),
+	[]byte("es"),
+	[]byte("aus"),
+	[]byte("s"),
+}
+
+var step2StepCallbacks = [...]func([]byte, []byte) []byte{
+	lengthen("l"),
+}
+
+var step2StepSuffixes = [...][]byte{
+	[]byte("de"),
+	[]byte("ge"),
+	[]byte("ische"),
+	[]byte("je"),
+	[]byte("lijke"),
+	[]byte("le"),
+	[]byte("ene"),
+	[]byte("re"),
+	[]byte("se"),
+	[]byte("te"),
+	[]byte("ieve"),
+}
+
+var step3StepCallbacks = [...]func([]byte, []byte) []byte{
+	lengthen("f"),
+	lengthen("g"),
+	lengthen(""),
+	replaceC("aar"),
+	lengthen("k"),
+	lengthen("r"),
+	replaceC("d"),
+	replaceC("t"),
+}
+
+var step3StepSuffixes = [...][]byte{
+	[]byte("heid"),
+	[]byte("fie"),
+	[]byte("gie"),
+	[]byte("atie"),
+	[]byte("isme"),
+	[]byte("arij"),
+	[]byte("erij"),
+	[]byte("sel"),
+	[]byte("ken"),
+	[]byte("der"),
+	[]byte("rster"),
+	[]byte("ster"),
+	[]byte("iteit"),
+	[]byte("dst"),
+	[]byte("tst"),
+}
+
+var step4aStepCallbacks = [...]func([]byte, []byte) []byte{
+	afterV("l"),
+	afterV("n"),
+	afterV("r"),
+}
+
+var step4aStepSuffixes = [...][]byte{
+	[]byte("end"),
+	[]byte("atief"),
+	[]byte("erig"),
+	[]byte("achtig"),
+	[]byte("ioneel"),
+	[]byte("baar"),
+	[]byte("laar"),
+	[]byte("naar"),
+	[]byte("raar"),
+	[]byte("eriger"),
+	[]byte("achtiger"),
+	[]byte("lijker"),
+	[]byte("tant"),
+	[]byte("erigst"),
+	[]byte("achtigst"),
+	[]byte("lijkst"),
+}
+
+var step4bStepSuffixes = [...][]byte{
+	[]byte("ig"),
+	[]byte("iger"),
+	[]byte("igst"),
+}
+
+var step6StepSuffixes = [...][]byte{
+	[]byte("bb"),
+	[]byte("cc"),
+	[]byte("dd"),
+	[]byte("ff"),
+	[]byte("gg"),
+	[]byte("hh"),
+	[]byte("jj"),
+	[]byte("kk"),
+	[]byte("ll"),
+	[]byte("mm"),
+	[]byte("nn"),
+	[]byte("pp"),
+	[]byte("qq"),
+	[]byte("rr"),
+	[]byte("ss"),
+	[]byte("tt"),
+	[]byte("vv"),
+	[]byte("ww"),
+	[]byte("xx"),
+	[]byte("zz"),
+}
+
+func step1StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							r := s[:len(s)-1]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					r := step1En(s, step1StepSuffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0x27:
+					r := s[:len(s)-2]
+					return r, true
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r1(s), step1StepSuffixes[1]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "ie"...)
+							return r, true
+						}
+					}
+					r := step1Es(s, step1StepSuffixes[2])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							r := step1Aus(s, step1StepSuffixes[3])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+			r := step1S(s, step1StepSuffixes[4])
+			if r == nil {
+				return s, false
+			}
+			return r, true
+		}
+	}
+	return s, false
+}
+
+func step2StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					r := deleteC(s, step2StepSuffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'g':
+					if !bytes.HasSuffix(r1(s), step2StepSuffixes[1]) {
+						return s, false
+					}
+					r := s[:len(s)-1]
+					return r, true
+				case 'h':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r1(s), step2StepSuffixes[2]) {
+												return s, false
+											}
+											r := s[:len(s)-1]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'j':
+					r := step2Je(s, step2StepSuffixes[3])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'k':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'j':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if !bytes.HasSuffix(r1(s), step2StepSuffixes[4]) {
+												return s, false
+											}
+											r := s[:len(s)-1]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'l':
+					if !bytes.HasSuffix(r1(s), step2StepSuffixes[5]) {
+						return s, false
+					}
+					r := step2StepCallbacks[0](s, step2StepSuffixes[5])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if !bytes.HasSuffix(r1(s), step2StepSuffixes[6]) {
+								return s, false
+							}
+							r := step2Ene(s, step2StepSuffixes[6])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 'r':
+					if !bytes.HasSuffix(r1(s), step2StepSuffixes[7]) {
+						return s, false
+					}
+					r := s[:len(s)-1]
+					return r, true
+				case 's':
+					if !bytes.HasSuffix(r1(s), step2StepSuffixes[8]) {
+						return s, false
+					}
+					r := s[:len(s)-1]
+					return r, true
+				case 't':
+					if !bytes.HasSuffix(r1(s), step2StepSuffixes[9]) {
+						return s, false
+					}
+					r := s[:len(s)-1]
+					return r, true
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r1(s), step2StepSuffixes[10]) {
+										return s, false
+									}
+									r := step2Ieve(s, step2StepSuffixes[10])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'h':
+									if !bytes.HasSuffix(r1(s), step3StepSuffixes[0]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'f':
+							if !bytes.HasSuffix(r2(s), step3StepSuffixes[1]) {
+								return s, false
+							}
+							r := step3StepCallbacks[0](s, step3StepSuffixes[1])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 'g':
+							if !bytes.HasSuffix(r2(s), step3StepSuffixes[2]) {
+								return s, false
+							}
+							r := step3StepCallbacks[1](s, step3StepSuffixes[2])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r1(s), step3StepSuffixes[3]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "eer"...)
+									return r, true
+								}
+							}
+						}
+					}
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r1(s), step3StepSuffixes[4]) {
+										return s, false
+									}
+									r := step3StepCallbacks[2](s, step3StepSuffixes[4])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'j':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r1(s), step3StepSuffixes[5]) {
+										return s, false
+									}
+									r := step3StepCallbacks[3](s, step3StepSuffixes[5])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								case 'e':
+									if !bytes.HasSuffix(r1(s), step3StepSuffixes[6]) {
+										return s, false
+									}
+									r := step3StepCallbacks[2](s, step3StepSuffixes[6])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if !bytes.HasSuffix(r1(s), step3StepSuffixes[7]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'k':
+							if !bytes.HasSuffix(r1(s), step3StepSuffixes[8]) {
+								return s, false
+							}
+							r := step3StepCallbacks[4](s, step3StepSuffixes[8])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if !bytes.HasSuffix(r1(s), step3StepSuffixes[9]) {
+								return s, false
+							}
+							r := step3StepCallbacks[5](s, step3StepSuffixes[9])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if !bytes.HasSuffix(r1(s), step3StepSuffixes[10]) {
+												return s, false
+											}
+											r := s[:len(s)-4]
+											return r, true
+										}
+									}
+									if !bytes.HasSuffix(r1(s), step3StepSuffixes[11]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r1(s), step3StepSuffixes[12]) {
+												return s, false
+											}
+											r := step3StepCallbacks[2](s, step3StepSuffixes[12])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if !bytes.HasSuffix(r1(s), step3StepSuffixes[13]) {
+								return s, false
+							}
+							r := step3StepCallbacks[6](s, step3StepSuffixes[13])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 't':
+							if !bytes.HasSuffix(r1(s), step3StepSuffixes[14]) {
+								return s, false
+							}
+							r := step3StepCallbacks[7](s, step3StepSuffixes[14])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step4aStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if !bytes.HasSuffix(r1(s), step4aStepSuffixes[0]) {
+								return s, false
+							}
+							r := deleteCLengthen(s, step4aStepSuffixes[0])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'f':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r1(s), step4aStepSuffixes[1]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "eer"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if !bytes.HasSuffix(r1(s), step4aStepSuffixes[2]) {
+										return s, false
+									}
+									r := deleteCLengthen(s, step4aStepSuffixes[2])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'h':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'c':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r1(s), step4aStepSuffixes[3]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'o':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if !bytes.HasSuffix(r1(s), step4aStepSuffixes[4]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "ie"...)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if !bytes.HasSuffix(r1(s), step4aStepSuffixes[5]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'l':
+									if !bytes.HasSuffix(r1(s), step4aStepSuffixes[6]) {
+										return s, false
+									}
+									r := step4aStepCallbacks[0](s, step4aStepSuffixes[6])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								case 'n':
+									if !bytes.HasSuffix(r1(s), step4aStepSuffixes[7]) {
+										return s, false
+									}
+									r := step4aStepCallbacks[1](s, step4aStepSuffixes[7])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								case 'r':
+									if !bytes.HasSuffix(r1(s), step4aStepSuffixes[8]) {
+										return s, false
+									}
+									r := step4aStepCallbacks[2](s, step4aStepSuffixes[8])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'g':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if !bytes.HasSuffix(r1(s), step4aStepSuffixes[9]) {
+														return s, false
+													}
+													r := deleteCLengthen(s, step4aStepSuffixes[9])
+													if r == nil {
+														return s, false
+													}
+													return r, true
+												}
+											}
+										case 't':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'h':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'c':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'a':
+																	if !bytes.HasSuffix(r1(s), step4aStepSuffixes[10]) {
+																		return s, false
+																	}
+																	r := s[:len(s)-8]
+																	return r, true
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'k':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'j':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'l':
+													if !bytes.HasSuffix(r1(s), step4aStepSuffixes[11]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "lijk"...)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if !bytes.HasSuffix(r1(s), step4aStepSuffixes[12]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "teer"...)
+									return r, true
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'g':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if !bytes.HasSuffix(r1(s), step4aStepSuffixes[13]) {
+														return s, false
+													}
+													r := deleteCLengthen(s, step4aStepSuffixes[13])
+													if r == nil {
+														return s, false
+													}
+													return r, true
+												}
+											}
+										case 't':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'h':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'c':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'a':
+																	if !bytes.HasSuffix(r1(s), step4aStepSuffixes[14]) {
+																		return s, false
+																	}
+																	r := s[:len(s)-8]
+																	return r, true
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'k':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'j':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'l':
+													if !bytes.HasSuffix(r1(s), step4aStepSuffixes[15]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "lijk"...)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step4bStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r1(s), step4bStepSuffixes[0]) {
+						return s, false
+					}
+					r := deleteCLengthen(s, step4bStepSuffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'g':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r1(s), step4bStepSuffixes[1]) {
+										return s, false
+									}
+									r := deleteCLengthen(s, step4bStepSuffixes[1])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'g':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r1(s), step4bStepSuffixes[2]) {
+										return s, false
+									}
+									r := deleteCLengthen(s, step4bStepSuffixes[2])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step6StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'b':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'b':
+					r := undouble(s, step6StepSuffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					r := undouble(s, step6StepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					r := undouble(s, step6StepSuffixes[2])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'f':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'f':
+					r := undouble(s, step6StepSuffixes[3])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'g':
+					r := undouble(s, step6StepSuffixes[4])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'h':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'h':
+					r := undouble(s, step6StepSuffixes[5])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'j':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'j':
+					r := undouble(s, step6StepSuffixes[6])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'k':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'k':
+					r := undouble(s, step6StepSuffixes[7])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					r := undouble(s, step6StepSuffixes[8])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'm':
+					r := undouble(s, step6StepSuffixes[9])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					r := undouble(s, step6StepSuffixes[10])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'p':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'p':
+					r := undouble(s, step6StepSuffixes[11])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'q':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'q':
+					r := undouble(s, step6StepSuffixes[12])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'r':
+					r := undouble(s, step6StepSuffixes[13])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					r := undouble(s, step6StepSuffixes[14])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					r := undouble(s, step6StepSuffixes[15])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'v':
+					r := undouble(s, step6StepSuffixes[16])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+			r := append(s[:len(s)-1], "f"...)
+			return r, true
+		case 'w':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'w':
+					r := undouble(s, step6StepSuffixes[17])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'x':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'x':
+					r := undouble(s, step6StepSuffixes[18])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'z':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'z':
+					r := undouble(s, step6StepSuffixes[19])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+			r := append(s[:len(s)-1], "s"...)
+			return r, true
+		}
+	}
+	return s, false
+}
+
+func step7StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'f':
+					r := s[:len(s)-1]
+					return r, true
+				case 'k':
+					r := s[:len(s)-1]
+					return r, true
+				case 'p':
+					r := s[:len(s)-1]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
@@ -0,0 +1,250 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Package lancaster implements the Lancaster (Paice/Husk) stemmer.
+package lancaster
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+// http://www.comp.lancs.ac.uk/computing/research/stemming/Links/paice.htm
+
+// A rule is written in the rule file as the reversed ending, an optional *
+// (only apply to intact words), the number of letters to remove, an
+// optional string to append and finally . (stop) or > (continue).
+type rule struct {
+	ending  string // reversed
+	intact  bool
+	remove  int
+	append  string
+	proceed bool
+}
+
+// Stemmer is a Lancaster stemmer with its own rule table.
+type Stemmer struct {
+	rules [256][]rule
+}
+
+// New parses the rules in the Paice/Husk rule file format from r.
+// Comments are written between braces and a rule reading end0. stops
+// the parsing.
+func New(r io.Reader) (*Stemmer, error) {
+	st := &Stemmer{}
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+	comment := false
+	for scanner.Scan() {
+		w := scanner.Text()
+		if comment || strings.HasPrefix(w, "{") {
+			comment = !strings.HasSuffix(w, "}")
+			continue
+		}
+		if w == "end0." {
+			break
+		}
+		x, err := parseRule(w)
+		if err != nil {
+			return nil, err
+		}
+		st.rules[x.ending[0]] = append(st.rules[x.ending[0]], x)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+func isLetter(b byte) bool {
+	return b >= 'a' && b <= 'z'
+}
+
+func parseRule(w string) (rule, error) {
+	var x rule
+	i := 0
+	for i < len(w) && isLetter(w[i]) {
+		i++
+	}
+	if i == 0 {
+		return x, fmt.Errorf("lancaster: rule %q: missing ending", w)
+	}
+	x.ending = w[:i]
+	if i < len(w) && w[i] == '*' {
+		x.intact = true
+		i++
+	}
+	if i == len(w) || w[i] < '0' || w[i] > '9' {
+		return x, fmt.Errorf("lancaster: rule %q: missing number of letters to remove", w)
+	}
+	x.remove = int(w[i] - '0')
+	i++
+	if x.remove > len(x.ending) {
+		return x, fmt.Errorf("lancaster: rule %q: removes more than the ending", w)
+	}
+	j := i
+	for i < len(w) && isLetter(w[i]) {
+		i++
+	}
+	x.append = w[j:i]
+	if i != len(w)-1 || (w[i] != '.' && w[i] != '>') {
+		return x, fmt.Errorf("lancaster: rule %q: must end with . or >", w)
+	}
+	x.proceed = w[i] == '>'
+	return x, nil
+}
+
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiou", b) >= 0
+}
+
+// acceptable reports whether stem can be left after removing an ending.
+// A stem beginning with a vowel must be at least 2 letters long, one
+// beginning with a consonant, y included, at least 3 letters long with
+// a vowel or y.
+func acceptable(stem []byte) bool {
+	if len(stem) == 0 {
+		return false
+	}
+	if isVowel(stem[0]) {
+		return len(stem) >= 2
+	}
+	if len(stem) < 3 {
+		return false
+	}
+	for _, b := range stem {
+		if isVowel(b) || b == 'y' {
+			return true
+		}
+	}
+	return false
+}
+
+func reverse(s string) string {
+	b := []byte(s)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+// hasReversedSuffix reports whether s ends with the reverse of r.
+func hasReversedSuffix(s []byte, r string) bool {
+	if len(s) < len(r) {
+		return false
+	}
+	for i := 0; i < len(r); i++ {
+		if s[len(s)-1-i] != r[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *rule) String() string {
+	s := r.ending
+	if r.intact {
+		s += "*"
+	}
+	s += strconv.Itoa(r.remove) + r.append
+	if r.proceed {
+		return s + ">"
+	}
+	return s + "."
+}
+
+func (st *Stemmer) StemBytes(s []byte) []byte {
+	return st.stemBytes(s, nil)
+}
+
+// Explain stems s and returns the rules applied to it. Each Trace has the
+// rule, as written in the rule file, as the step.
+func (st *Stemmer) Explain(s []byte) []porter2.Trace {
+	t := porter2.NewTracer(s)
+	st.stemBytes(s, t)
+	return t.Traces
+}
+
+// maxRules is the most rules applied to a word. A table may loop
+// forever otherwise, for example with a1b> and b1a>.
+const maxRules = 64
+
+func (st *Stemmer) stemBytes(s []byte, t *porter2.Tracer) []byte {
+	intact := true
+	for n := 0; n < maxRules && len(s) > 0; n++ {
+		var x *rule
+		for i := range st.rules[s[len(s)-1]] {
+			r := &st.rules[s[len(s)-1]][i]
+			if (!r.intact || intact) && hasReversedSuffix(s, r.ending) && acceptable(s[:len(s)-r.remove]) {
+				x = r
+				break
+			}
+		}
+		if x == nil || (x.remove == 0 && x.append == "") {
+			break
+		}
+		s = append(s[:len(s)-x.remove], x.append...)
+		if t != nil {
+			t.RecordSuffix(x.String(), s, reverse(x.ending), "")
+		}
+		intact = false
+		if !x.proceed {
+			break
+		}
+	}
+	return s
+}
+
+func (st *Stemmer) StemString(s string) string {
+	return string(st.StemBytes([]byte(s)))
+}
+
+var standard *Stemmer
+
+func init() {
+	var err error
+	standard, err = New(strings.NewReader(StandardRules))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func StemBytes(s []byte) []byte {
+	return standard.StemBytes(s)
+}
+
+// Explain stems s with the standard rules and returns the rules applied
+// to it.
+func Explain(s []byte) []porter2.Trace {
+	return standard.Explain(s)
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
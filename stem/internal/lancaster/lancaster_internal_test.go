@@ -0,0 +1,91 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package lancaster
+
+import (
+	"strings"
+	"testing"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func TestParseRule(t *testing.T) {
+	x, err := parseRule("tpec2iv.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x != (rule{"tpec", false, 2, "iv", false}) {
+		t.Errorf("parseRule(%q) = %+v", "tpec2iv.", x)
+	}
+	x, err = parseRule("ai*2>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x != (rule{"ai", true, 2, "", true}) {
+		t.Errorf("parseRule(%q) = %+v", "ai*2>", x)
+	}
+	for _, w := range []string{"*2.", "ai2", "ai*.", "ai5.", "ai2x", "ai2.>", "AI2."} {
+		if _, err := parseRule(w); err == nil {
+			t.Errorf("parseRule(%q): expected error", w)
+		}
+	}
+}
+
+func TestAcceptable(t *testing.T) {
+	for w, want := range map[string]bool{
+		"ow": true, "e": false, "say": true, "str": false, "me": false, "cry": true, "": false,
+		"ye": false, "yes": true,
+	} {
+		if got := acceptable([]byte(w)); got != want {
+			t.Errorf("acceptable(%q) = %v; want %v", w, got, want)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	st, err := New(strings.NewReader(`{ a custom table }
+gni3>  { -ing > - }
+nn1.
+end0.
+ssen4> { ignored }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs := []string{"running", "run", "happiness", "happiness", "sing", "sing"}
+	test(t, st.StemBytes, pairs)
+
+	if _, err := New(strings.NewReader("gni3> bad")); err == nil {
+		t.Error("New: expected error")
+	}
+}
+
+func TestCycle(t *testing.T) {
+	st, err := New(strings.NewReader("a1b> b1a>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := st.StemString("tala"); s != "tala" && s != "talb" {
+		t.Errorf("StemString(%q) = %q", "tala", s)
+	}
+}
@@ -0,0 +1,269 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Package lovins implements the Lovins stemmer.
+package lovins
+
+import (
+	"bytes"
+	"strings"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/lovins/stemmer.html
+
+// Each line lists the endings removed under the condition named by its
+// first field.
+const endingsTable = `
+A arizability antialness arisations arizations entialness antaneous antiality arisation arization ativeness entations entiality entialize entiation ionalness istically itousness izability izational ableness arizable entation entially eousness ibleness icalness ionalism ionality ionalize iousness izations lessness ability aically alities aristic arizing ateness atingly atively ativism encible entally entials entiate entness fulness ibility icalism icalist icality icalize icianry ination ingness ionally isation ishness istical iteness iveness ivistic ivities izement oidally ousness aceous alness ancial ancies ariser arized arizer atable atives efully encies encing ential entist eously ialist iality ialize ically icance icians icists ifully ionals ioning ionist iously istics lessly nesses oidism acies acity aical alist ality alize arial aries arily arize aroid ately ative ators atory ehood eless elity ement enced ences ental ently fully ially icant ician icide icism icist icity iedly ihood inate iness ional ioned ished istic ities itous ively ivity oidal oides otide ously able ably aric ates ator eful eity ence ency eous hood ials ians ible ibly ical iers iful ious ists less lily ness ogen ward wise yish acy aic ata ate ese ful ial ian ics ied ier ily ist ity ium ive oid ous ae ia ic is 's s' a e i o
+B alistically izationally ationally alistic ational acious ancing ations aging alism anced ances arity ation ingly ages ally ance ancy ants atic ions isms ying age ant ism as ly y
+C allically antic ented enting ent ish
+D ionate
+E eableness ariness elihood ature eness ening edly ened enly ely ene ery ed es
+F ization izable izers izing ized izer ary ize en
+G ication action
+H itic
+I ating idine ated
+J inism
+K arly
+L ides ide
+M ines ine
+N ings ing
+O ars
+P ies
+Q ion
+R one yl
+S on
+T or
+U um
+V us
+W s
+X ar
+Y early ealy eal ear
+Z eature
+AA ite
+BB allic als al
+CC inity
+`
+
+func endsAny(s []byte, suffixes ...string) bool {
+	for _, x := range suffixes {
+		if bytes.HasSuffix(s, []byte(x)) {
+			return true
+		}
+	}
+	return false
+}
+
+func atLeast(n int) func([]byte) bool {
+	return func(s []byte) bool {
+		return len(s) >= n
+	}
+}
+
+// not returns a condition satisfied by stems of at least n letters not
+// ending with any of suffixes.
+func not(n int, suffixes ...string) func([]byte) bool {
+	return func(s []byte) bool {
+		return len(s) >= n && !endsAny(s, suffixes...)
+	}
+}
+
+// only returns a condition satisfied by stems of at least n letters
+// ending with one of suffixes.
+func only(n int, suffixes ...string) func([]byte) bool {
+	return func(s []byte) bool {
+		return len(s) >= n && endsAny(s, suffixes...)
+	}
+}
+
+// endsUxE reports whether s ends with u, any letter, e.
+func endsUxE(s []byte) bool {
+	return len(s) >= 3 && s[len(s)-1] == 'e' && s[len(s)-3] == 'u'
+}
+
+// The conditions are satisfied by the stem left after removing an ending.
+var conditions = map[string]func([]byte) bool{
+	"A": atLeast(2),
+	"B": atLeast(3),
+	"C": atLeast(4),
+	"D": atLeast(5),
+	"E": not(2, "e"),
+	"F": not(3, "e"),
+	"G": only(3, "f"),
+	"H": only(2, "t", "ll"),
+	"I": not(2, "o", "e"),
+	"J": not(2, "a", "e"),
+	"K": func(s []byte) bool {
+		return len(s) >= 3 && (endsAny(s, "l", "i") || endsUxE(s))
+	},
+	"L": func(s []byte) bool {
+		return len(s) >= 2 && !endsAny(s, "u", "x") && (!endsAny(s, "s") || endsAny(s, "os"))
+	},
+	"M": not(2, "a", "c", "e", "m"),
+	"N": func(s []byte) bool {
+		if len(s) >= 3 && s[len(s)-3] == 's' {
+			return len(s) >= 4
+		}
+		return len(s) >= 3
+	},
+	"O": only(2, "l", "i"),
+	"P": not(2, "c"),
+	"Q": not(3, "l", "n"),
+	"R": only(2, "n", "r"),
+	"S": func(s []byte) bool {
+		return len(s) >= 2 && (endsAny(s, "dr") || endsAny(s, "t") && !endsAny(s, "tt"))
+	},
+	"T": func(s []byte) bool {
+		return len(s) >= 2 && (endsAny(s, "s") || endsAny(s, "t") && !endsAny(s, "ot"))
+	},
+	"U": only(2, "l", "m", "n", "r"),
+	"V": only(2, "c"),
+	"W": not(2, "s", "u"),
+	"X": func(s []byte) bool {
+		return len(s) >= 2 && (endsAny(s, "l", "i") || endsUxE(s))
+	},
+	"Y":  only(2, "in"),
+	"Z":  not(2, "f"),
+	"AA": only(2, "d", "f", "ph", "th", "l", "er", "or", "es", "t"),
+	"BB": not(3, "met", "ryst"),
+	"CC": only(2, "l"),
+}
+
+const maxEnding = 11
+
+var endings = map[string]func([]byte) bool{}
+
+func init() {
+	for _, line := range strings.Split(endingsTable, "\n") {
+		fs := strings.Fields(line)
+		if len(fs) == 0 {
+			continue
+		}
+		for _, e := range fs[1:] {
+			endings[e] = conditions[fs[0]]
+		}
+	}
+}
+
+// removeEnding removes the longest ending whose condition holds.
+func removeEnding(s []byte) []byte {
+	for l := maxEnding; l > 0; l-- {
+		if l > len(s)-2 {
+			continue
+		}
+		if cond, ok := endings[string(s[len(s)-l:])]; ok && cond(s[:len(s)-l]) {
+			return s[:len(s)-l]
+		}
+	}
+	return s
+}
+
+func undouble(s []byte) []byte {
+	if endsAny(s, "bb", "dd", "gg", "ll", "mm", "nn", "pp", "rr", "ss", "tt") {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// notAfter returns a callback replacing the suffix with by unless it
+// follows one of the letters in prev.
+func notAfter(prev string, by string) func([]byte, []byte) []byte {
+	return func(s []byte, suffix []byte) []byte {
+		p := s[:len(s)-len(suffix)]
+		if len(p) > 0 && strings.IndexByte(prev, p[len(p)-1]) >= 0 {
+			return nil
+		}
+		return append(p, by...)
+	}
+}
+
+var respellStep = MustNewStep([]Suffix{
+	{Suffixes: "iev", Callback: Replace("ief")},
+	{Suffixes: "uct", Callback: Replace("uc")},
+	{Suffixes: "umpt", Callback: Replace("um")},
+	{Suffixes: "rpt", Callback: Replace("rb")},
+	{Suffixes: "urs", Callback: Replace("ur")},
+	{Suffixes: "istr", Callback: Replace("ister")},
+	{Suffixes: "metr", Callback: Replace("meter")},
+	{Suffixes: "olv", Callback: Replace("olut")},
+	{Suffixes: "ul", Callback: notAfter("aio", "l")},
+	{Suffixes: "bex", Callback: Replace("bic")},
+	{Suffixes: "dex", Callback: Replace("dic")},
+	{Suffixes: "pex", Callback: Replace("pic")},
+	{Suffixes: "tex", Callback: Replace("tic")},
+	{Suffixes: "ax", Callback: Replace("ac")},
+	{Suffixes: "ex", Callback: Replace("ec")},
+	{Suffixes: "ix", Callback: Replace("ic")},
+	{Suffixes: "lux", Callback: Replace("luc")},
+	{Suffixes: "uad", Callback: Replace("uas")},
+	{Suffixes: "vad", Callback: Replace("vas")},
+	{Suffixes: "cid", Callback: Replace("cis")},
+	{Suffixes: "lid", Callback: Replace("lis")},
+	{Suffixes: "erid", Callback: Replace("eris")},
+	{Suffixes: "pand", Callback: Replace("pans")},
+	{Suffixes: "end", Callback: notAfter("s", "ens")},
+	{Suffixes: "ond", Callback: Replace("ons")},
+	{Suffixes: "lud", Callback: Replace("lus")},
+	{Suffixes: "rud", Callback: Replace("rus")},
+	{Suffixes: "her", Callback: notAfter("pt", "hes")},
+	{Suffixes: "mit", Callback: Replace("mis")},
+	{Suffixes: "ent", Callback: notAfter("m", "ens")},
+	{Suffixes: "ert", Callback: Replace("ers")},
+	{Suffixes: "et", Callback: notAfter("n", "es")},
+	{Suffixes: "yt", Callback: Replace("ys")},
+	{Suffixes: "yz", Callback: Replace("ys")},
+})
+
+func respell(s []byte, t *Tracer) []byte {
+	return t.Hook(respellStep).Apply(s)
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = removeEnding(s)
+	t.Record("removeEnding", s)
+	s = undouble(s)
+	t.Record("undouble", s)
+	s = respell(s, t)
+	t.Record("respell", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
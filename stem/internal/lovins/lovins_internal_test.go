@@ -0,0 +1,51 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package lovins
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestRemoveEnding(t *testing.T) {
+	pairs := []string{"nationally", "nat", "sitting", "sitt", "saying", "saying", "magnesia", "magnes", "cement", "cement", "is", "is"}
+	test(t, removeEnding, pairs)
+}
+
+func TestUndouble(t *testing.T) {
+	pairs := []string{"sitt", "sit", "happ", "hap", "fee", "fee"}
+	test(t, undouble, pairs)
+}
+
+func TestRespell(t *testing.T) {
+	pairs := []string{"absorpt", "absorb", "depend", "depens", "descend", "descens", "send", "send", "cement", "cement", "magnet", "magnet", "consul", "consl", "foul", "foul", "matrix", "matric"}
+	test(t, untraced(respell), pairs)
+}
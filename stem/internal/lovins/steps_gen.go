@@ -0,0 +1,294 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package lovins
+
+import ()
+
+func init() {
+	respellStep.SetDo(respellStepDo)
+}
+
+var respellStepCallbacks = [...]func([]byte, []byte) []byte{
+	notAfter("s", "ens"),
+	notAfter("aio", "l"),
+	notAfter("pt", "hes"),
+	notAfter("n", "es"),
+	notAfter("m", "ens"),
+}
+
+var respellStepSuffixes = [...][]byte{
+	[]byte("end"),
+	[]byte("ul"),
+	[]byte("her"),
+	[]byte("et"),
+	[]byte("ent"),
+}
+
+func respellStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'u':
+							r := append(s[:len(s)-3], "uas"...)
+							return r, true
+						case 'v':
+							r := append(s[:len(s)-3], "vas"...)
+							return r, true
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							r := append(s[:len(s)-3], "cis"...)
+							return r, true
+						case 'l':
+							r := append(s[:len(s)-3], "lis"...)
+							return r, true
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									r := append(s[:len(s)-4], "eris"...)
+									return r, true
+								}
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'p':
+									r := append(s[:len(s)-4], "pans"...)
+									return r, true
+								}
+							}
+						case 'e':
+							r := respellStepCallbacks[0](s, respellStepSuffixes[0])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 'o':
+							r := append(s[:len(s)-3], "ons"...)
+							return r, true
+						}
+					}
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							r := append(s[:len(s)-3], "lus"...)
+							return r, true
+						case 'r':
+							r := append(s[:len(s)-3], "rus"...)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'u':
+					r := respellStepCallbacks[1](s, respellStepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'h':
+							r := respellStepCallbacks[2](s, respellStepSuffixes[2])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'm':
+									r := append(s[:len(s)-4], "meter"...)
+									return r, true
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									r := append(s[:len(s)-4], "ister"...)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'u':
+							r := append(s[:len(s)-3], "ur"...)
+							return r, true
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'u':
+							r := append(s[:len(s)-3], "uc"...)
+							return r, true
+						}
+					}
+				case 'e':
+					r := respellStepCallbacks[3](s, respellStepSuffixes[3])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							r := append(s[:len(s)-3], "mis"...)
+							return r, true
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							r := respellStepCallbacks[4](s, respellStepSuffixes[4])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 'p':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'u':
+									r := append(s[:len(s)-4], "um"...)
+									return r, true
+								}
+							}
+						case 'r':
+							r := append(s[:len(s)-3], "rb"...)
+							return r, true
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							r := append(s[:len(s)-3], "ers"...)
+							return r, true
+						}
+					}
+				case 'y':
+					r := append(s[:len(s)-2], "ys"...)
+					return r, true
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := append(s[:len(s)-3], "ief"...)
+							return r, true
+						}
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							r := append(s[:len(s)-3], "olut"...)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'x':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					r := append(s[:len(s)-2], "ac"...)
+					return r, true
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							r := append(s[:len(s)-3], "bic"...)
+							return r, true
+						case 'd':
+							r := append(s[:len(s)-3], "dic"...)
+							return r, true
+						case 'p':
+							r := append(s[:len(s)-3], "pic"...)
+							return r, true
+						case 't':
+							r := append(s[:len(s)-3], "tic"...)
+							return r, true
+						}
+					}
+					r := append(s[:len(s)-2], "ec"...)
+					return r, true
+				case 'i':
+					r := append(s[:len(s)-2], "ic"...)
+					return r, true
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							r := append(s[:len(s)-3], "luc"...)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'z':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'y':
+					r := append(s[:len(s)-2], "ys"...)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
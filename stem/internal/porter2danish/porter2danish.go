@@ -0,0 +1,126 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2danish
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/danish/stemmer.html
+
+const vowels = "aeiouyæåø"
+
+var r1 = ScandinavianR1(vowels)
+
+func step1S(s []byte, suffix []byte) []byte {
+	const validSEnding = "abcdfghjklmnoprtvyzå"
+	if r, l := utf8.DecodeLastRune(s[:len(s)-len(suffix)]); l > 0 && strings.ContainsRune(validSEnding, r) {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "hed ethed ered e erede ende erende ene erne ere en heden eren er heder erer heds es endes erendes enes ernes eres ens hedens erens ers ets erets et eret", Callback: Delete, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "s", Callback: step1S, MatchRegion: r1, Region: "R1"},
+})
+
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
+}
+
+func undouble(s []byte, suffix []byte) []byte {
+	return s[:len(s)-1]
+}
+
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "gd dt gt kt", Callback: undouble, MatchRegion: r1, Region: "R1"},
+})
+
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
+}
+
+var step3Step = MustNewStep([]Suffix{
+	{Suffixes: "ig lig elig els", Callback: Delete, MatchRegion: r1, Region: "R1",
+		SuffixCallbacks: []Suffix{{Suffixes: "gd dt gt kt", Callback: undouble, MatchRegion: r1, Region: "R1"}},
+	},
+	{Suffixes: "løst", Callback: Truncate("løs"), MatchRegion: r1, Region: "R1"},
+})
+
+func step3(s []byte, t *Tracer) []byte {
+	if bytes.HasSuffix(s, []byte("igst")) {
+		s = s[:len(s)-len("st")]
+	}
+	return t.Hook(step3Step).Apply(s)
+}
+
+// step4 undoubles a final consonant in R1.
+func step4(s []byte) []byte {
+	r, l := utf8.DecodeLastRune(s)
+	if l == 0 || strings.ContainsRune(vowels, r) || len(r1(s)) < l {
+		return s
+	}
+	if bytes.HasSuffix(s[:len(s)-l], s[len(s)-l:]) {
+		s = s[:len(s)-l]
+	}
+	return s
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = step1(s, t)
+	t.Record("step1", s)
+	s = step2(s, t)
+	t.Record("step2", s)
+	s = step3(s, t)
+	t.Record("step3", s)
+	s = step4(s)
+	t.Record("step4", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+// form keeps æ, which is a letter of the alphabet.
+var form = textnorm.Form{Letters: "æ"}
+
+func NormalizeBytes(b []byte) []byte {
+	return form.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
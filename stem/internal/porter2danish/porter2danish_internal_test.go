@@ -0,0 +1,61 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2danish
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestR1(t *testing.T) {
+	pairs := []string{"indtagelse", "tagelse", "åens", "s"}
+	test(t, r1, pairs)
+}
+
+func TestStep1(t *testing.T) {
+	pairs := []string{"indtagelse", "indtagels", "kommunerne", "kommun", "indvortes", "indvort", "husets", "hus"}
+	test(t, untraced(step1), pairs)
+}
+
+func TestStep2(t *testing.T) {
+	pairs := []string{"indtægt", "indtæg", "indtil", "indtil"}
+	test(t, untraced(step2), pairs)
+}
+
+func TestStep3(t *testing.T) {
+	pairs := []string{"lykkeligst", "lykk", "venligst", "ven", "hjælpeløst", "hjælpeløs", "indtagels", "indtag"}
+	test(t, untraced(step3), pairs)
+}
+
+func TestStep4(t *testing.T) {
+	pairs := []string{"komm", "kom", "lykk", "lyk", "kaffe", "kaffe", "all", "all"}
+	test(t, step4, pairs)
+}
@@ -0,0 +1,650 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2danish
+
+import (
+	"bytes"
+)
+
+func init() {
+	step1Step.SetDo(step1StepDo)
+	step2Step.SetDo(step2StepDo)
+	step3Step.SetDo(step3StepDo)
+}
+
+var step1StepSuffixes = [...][]byte{
+	[]byte("ethed"),
+	[]byte("hed"),
+	[]byte("ered"),
+	[]byte("erede"),
+	[]byte("erende"),
+	[]byte("ende"),
+	[]byte("ene"),
+	[]byte("erne"),
+	[]byte("ere"),
+	[]byte("e"),
+	[]byte("heden"),
+	[]byte("eren"),
+	[]byte("en"),
+	[]byte("heder"),
+	[]byte("erer"),
+	[]byte("er"),
+	[]byte("heds"),
+	[]byte("erendes"),
+	[]byte("endes"),
+	[]byte("enes"),
+	[]byte("ernes"),
+	[]byte("eres"),
+	[]byte("es"),
+	[]byte("hedens"),
+	[]byte("erens"),
+	[]byte("ens"),
+	[]byte("ers"),
+	[]byte("erets"),
+	[]byte("ets"),
+	[]byte("s"),
+	[]byte("eret"),
+	[]byte("et"),
+}
+
+var step2StepSuffixes = [...][]byte{
+	[]byte("gd"),
+	[]byte("dt"),
+	[]byte("gt"),
+	[]byte("kt"),
+}
+
+var step3StepNested0Suffixes = [...][]byte{
+	[]byte("gd"),
+	[]byte("dt"),
+	[]byte("gt"),
+	[]byte("kt"),
+}
+
+var step3StepSuffixes = [...][]byte{
+	[]byte("elig"),
+	[]byte("lig"),
+	[]byte("ig"),
+	[]byte("els"),
+	[]byte("løst"),
+}
+
+func step1StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'h':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[0]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[1]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[2]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[3]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if bytes.HasSuffix(r1(s), step1StepSuffixes[4]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[5]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[6]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[7]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[8]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+			if bytes.HasSuffix(r1(s), step1StepSuffixes[9]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'h':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[10]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[11]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[12]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'h':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[13]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[14]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[15]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'h':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[16]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'r':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'e':
+															if bytes.HasSuffix(r1(s), step1StepSuffixes[17]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												}
+											}
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[18]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[19]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[20]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[21]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[22]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'd':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'h':
+													if bytes.HasSuffix(r1(s), step1StepSuffixes[23]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[24]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[25]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[26]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[27]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[28]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+			if bytes.HasSuffix(r1(s), step1StepSuffixes[29]) {
+				r := step1S(s, step1StepSuffixes[29])
+				if r == nil {
+					return s, false
+				}
+				return r, true
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[30]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[31]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'g':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[0]) {
+						r := undouble(s, step2StepSuffixes[0])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[1]) {
+						r := undouble(s, step2StepSuffixes[1])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'g':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[2]) {
+						r := undouble(s, step2StepSuffixes[2])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'k':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[3]) {
+						r := undouble(s, step2StepSuffixes[3])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepNested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'g':
+					if bytes.HasSuffix(r1(s), step3StepNested0Suffixes[0]) {
+						r := undouble(s, step3StepNested0Suffixes[0])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if bytes.HasSuffix(r1(s), step3StepNested0Suffixes[1]) {
+						r := undouble(s, step3StepNested0Suffixes[1])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'g':
+					if bytes.HasSuffix(r1(s), step3StepNested0Suffixes[2]) {
+						r := undouble(s, step3StepNested0Suffixes[2])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'k':
+					if bytes.HasSuffix(r1(s), step3StepNested0Suffixes[3]) {
+						r := undouble(s, step3StepNested0Suffixes[3])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r1(s), step3StepSuffixes[0]) {
+										r := s[:len(s)-4]
+										r, _ = step3StepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+							if bytes.HasSuffix(r1(s), step3StepSuffixes[1]) {
+								r := s[:len(s)-3]
+								r, _ = step3StepNested0Do(r)
+								return r, true
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step3StepSuffixes[2]) {
+						r := s[:len(s)-2]
+						r, _ = step3StepNested0Do(r)
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if bytes.HasSuffix(r1(s), step3StepSuffixes[3]) {
+								r := s[:len(s)-3]
+								r, _ = step3StepNested0Do(r)
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if bytes.HasSuffix(r1(s), step3StepSuffixes[4]) {
+												r := s[:len(s)-1]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
@@ -0,0 +1,262 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2dutch
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/dutch/stemmer.html
+
+const vowels = "aeiouyè"
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune(vowels, r)
+}
+
+var (
+	r1 = ScandinavianR1(vowels)
+	r2 = R2(vowels)
+)
+
+func normalize(s []byte) []byte {
+	// Remove umlauts and acute accents. The result is never longer than s.
+	w := 0
+	for i := 0; i < len(s); {
+		r, l := utf8.DecodeRune(s[i:])
+		switch r {
+		case 'ä', 'á':
+			r = 'a'
+		case 'ë', 'é':
+			r = 'e'
+		case 'ï', 'í':
+			r = 'i'
+		case 'ö', 'ó':
+			r = 'o'
+		case 'ü', 'ú':
+			r = 'u'
+		}
+		if r == utf8.RuneError {
+			copy(s[w:], s[i:i+l])
+			w += l
+		} else {
+			w += utf8.EncodeRune(s[w:], r)
+		}
+		i += l
+	}
+	s = s[:w]
+
+	if len(s) > 0 && s[0] == 'y' {
+		s[0] = 'Y'
+	}
+	for i := 0; i < len(s); {
+		r, l := utf8.DecodeRune(s[i:])
+		j := i + l
+		if isVowel(r) && j < len(s) {
+			if s[j] == 'i' {
+				nr, nl := utf8.DecodeRune(s[j+1:])
+				if isVowel(nr) {
+					s[j] = 'I'
+					i = j + 1 + nl
+					continue
+				}
+			} else if s[j] == 'y' {
+				s[j] = 'Y'
+				i = j + 1
+				continue
+			}
+		}
+		i = j
+	}
+	return s
+}
+
+func undouble(s []byte) []byte {
+	if bytes.HasSuffix(s, []byte("kk")) || bytes.HasSuffix(s, []byte("dd")) || bytes.HasSuffix(s, []byte("tt")) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// enEnding deletes suffix if it is preceded by a non-vowel
+// other than the gem ending and then undoubles.
+func enEnding(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	r, l := utf8.DecodeLastRune(m)
+	if l == 0 || isVowel(r) || bytes.HasSuffix(m, []byte("gem")) {
+		return nil
+	}
+	return undouble(m)
+}
+
+func sEnding(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	r, l := utf8.DecodeLastRune(m)
+	if l == 0 || isVowel(r) || r == 'j' {
+		return nil
+	}
+	return m
+}
+
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "heden", Callback: Replace("heid"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "en ene", Callback: enEnding, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "s se", Callback: sEnding, ActionRegion: r1, Region: "R1"},
+})
+
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
+}
+
+// step2 also reports whether an e was removed.
+func step2(s []byte) ([]byte, bool) {
+	if !bytes.HasSuffix(r1(s), []byte("e")) {
+		return s, false
+	}
+	r, l := utf8.DecodeLastRune(s[:len(s)-1])
+	if l == 0 || isVowel(r) {
+		return s, false
+	}
+	return undouble(s[:len(s)-1]), true
+}
+
+func step3aHeid(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	if bytes.HasSuffix(m, []byte("c")) {
+		return nil
+	}
+	if bytes.HasSuffix(r1(m), []byte("en")) {
+		if e := enEnding(m, []byte("en")); e != nil {
+			m = e
+		}
+	}
+	return m
+}
+
+var step3aStep = MustNewStep([]Suffix{
+	{Suffixes: "heid", Callback: step3aHeid, ActionRegion: r2, Region: "R2"},
+})
+
+func step3a(s []byte, t *Tracer) []byte {
+	return t.Hook(step3aStep).Apply(s)
+}
+
+func step3bEnd(s []byte, suffix []byte) []byte {
+	s = Delete(s, suffix)
+	if bytes.HasSuffix(r2(s), []byte("ig")) && !bytes.HasSuffix(s, []byte("eig")) {
+		return s[:len(s)-len("ig")]
+	}
+	return undouble(s)
+}
+
+func step3bIg(s []byte, suffix []byte) []byte {
+	if bytes.HasSuffix(s[:len(s)-len(suffix)], []byte("e")) {
+		return nil
+	}
+	return Delete(s, suffix)
+}
+
+func step3bLijk(s []byte, suffix []byte) []byte {
+	s, _ = step2(Delete(s, suffix))
+	return s
+}
+
+var step3bStep = MustNewStep([]Suffix{
+	{Suffixes: "end ing", Callback: step3bEnd, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "ig", Callback: step3bIg, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "lijk", Callback: step3bLijk, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "baar", Callback: Delete, ActionRegion: r2, Region: "R2"},
+})
+
+// step3b removes bar only if step2 removed an e.
+func step3b(s []byte, eFound bool, t *Tracer) []byte {
+	if eFound && bytes.HasSuffix(r2(s), []byte("bar")) {
+		return s[:len(s)-len("bar")]
+	}
+	return t.Hook(step3bStep).Apply(s)
+}
+
+// step4 undoubles the vowel of a final consonant-vowel-vowel-consonant.
+func step4(s []byte) []byte {
+	d, l := utf8.DecodeLastRune(s)
+	if l == 0 || isVowel(d) || d == 'I' || len(s) < l+2 {
+		return s
+	}
+	i := len(s) - l - 2
+	v := s[i : i+2]
+	if v[0] != v[1] || strings.IndexByte("aeou", v[0]) < 0 {
+		return s
+	}
+	if c, cl := utf8.DecodeLastRune(s[:i]); cl == 0 || isVowel(c) {
+		return s
+	}
+	copy(s[i+1:], s[i+2:])
+	return s[:len(s)-1]
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = normalize(s)
+	t.Record("normalize", s)
+	s = step1(s, t)
+	t.Record("step1", s)
+	s, eFound := step2(s)
+	t.Record("step2", s)
+	s = step3a(s, t)
+	t.Record("step3a", s)
+	s = step3b(s, eFound, t)
+	t.Record("step3b", s)
+	s = step4(s)
+	t.Record("step4", s)
+	for i, b := range s {
+		if b == 'I' {
+			s[i] = 'i'
+		} else if b == 'Y' {
+			s[i] = 'y'
+		}
+	}
+	t.Record("postlude", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
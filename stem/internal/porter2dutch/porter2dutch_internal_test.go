@@ -0,0 +1,71 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2dutch
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestNormalize(t *testing.T) {
+	pairs := []string{"reëel", "reeel", "café", "cafe", "yoghurt", "Yoghurt", "mooie", "mooIe", "kooien", "kooIen", "haye", "haYe"}
+	test(t, normalize, pairs)
+}
+
+func TestR1(t *testing.T) {
+	pairs := []string{"lichamelijk", "hamelijk", "opdat", "at", "ab", ""}
+	test(t, r1, pairs)
+}
+
+func TestR2(t *testing.T) {
+	pairs := []string{"lichamelijk", "elijk", "opdat", ""}
+	test(t, r2, pairs)
+}
+
+func TestStep1(t *testing.T) {
+	pairs := []string{"mogelijkheden", "mogelijkheid", "opdrachten", "opdracht", "bidden", "bid", "opdrachtgevers", "opdrachtgever", "huizen", "huiz", "ijs", "ijs", "geheimen", "geheim"}
+	test(t, untraced(step1), pairs)
+}
+
+func TestStep3b(t *testing.T) {
+	pairs := []string{"lichtend", "lichtend", "lichamelijk", "licham", "opbouwbaar", "opbouw"}
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(step3b([]byte(pairs[i]), false, nil))
+		if actual != pairs[i+1] {
+			t.Errorf("step3b(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+}
+
+func TestStep4(t *testing.T) {
+	pairs := []string{"maan", "man", "brood", "brod", "aan", "aan", "taaI", "taaI"}
+	test(t, step4, pairs)
+}
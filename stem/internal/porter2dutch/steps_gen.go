@@ -0,0 +1,244 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2dutch
+
+import (
+	"bytes"
+)
+
+func init() {
+	step1Step.SetDo(step1StepDo)
+	step3aStep.SetDo(step3aStepDo)
+	step3bStep.SetDo(step3bStepDo)
+}
+
+var step1StepSuffixes = [...][]byte{
+	[]byte("ene"),
+	[]byte("se"),
+	[]byte("heden"),
+	[]byte("en"),
+	[]byte("s"),
+}
+
+var step3aStepSuffixes = [...][]byte{
+	[]byte("heid"),
+}
+
+var step3bStepSuffixes = [...][]byte{
+	[]byte("end"),
+	[]byte("ig"),
+	[]byte("ing"),
+	[]byte("lijk"),
+	[]byte("baar"),
+}
+
+func step1StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if !bytes.HasSuffix(r1(s), step1StepSuffixes[0]) {
+								return s, false
+							}
+							r := enEnding(s, step1StepSuffixes[0])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 's':
+					if !bytes.HasSuffix(r1(s), step1StepSuffixes[1]) {
+						return s, false
+					}
+					r := sEnding(s, step1StepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'h':
+											if !bytes.HasSuffix(r1(s), step1StepSuffixes[2]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "heid"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+					if !bytes.HasSuffix(r1(s), step1StepSuffixes[3]) {
+						return s, false
+					}
+					r := enEnding(s, step1StepSuffixes[3])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 's':
+			if !bytes.HasSuffix(r1(s), step1StepSuffixes[4]) {
+				return s, false
+			}
+			r := sEnding(s, step1StepSuffixes[4])
+			if r == nil {
+				return s, false
+			}
+			return r, true
+		}
+	}
+	return s, false
+}
+
+func step3aStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'h':
+									if !bytes.HasSuffix(r2(s), step3aStepSuffixes[0]) {
+										return s, false
+									}
+									r := step3aHeid(s, step3aStepSuffixes[0])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3bStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if !bytes.HasSuffix(r2(s), step3bStepSuffixes[0]) {
+								return s, false
+							}
+							r := step3bEnd(s, step3bStepSuffixes[0])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step3bStepSuffixes[1]) {
+						return s, false
+					}
+					r := step3bIg(s, step3bStepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step3bStepSuffixes[2]) {
+								return s, false
+							}
+							r := step3bEnd(s, step3bStepSuffixes[2])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'k':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'j':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if !bytes.HasSuffix(r2(s), step3bStepSuffixes[3]) {
+										return s, false
+									}
+									r := step3bLijk(s, step3bStepSuffixes[3])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if !bytes.HasSuffix(r2(s), step3bStepSuffixes[4]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
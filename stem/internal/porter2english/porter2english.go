@@ -18,9 +18,12 @@ package porter2english
 import (
 	"bytes"
 
-	. "xojoc.pw/nlp/stem/internal/porter2"
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
 )
 
+//go:generate go run ../../porter2/porter2gen/main.go
+
 // http://snowballstem.org/algorithms/english/stemmer.html
 
 const vowels = "aeiouy"
@@ -124,12 +127,12 @@ func isShort(s []byte) bool {
 	return len(r1(s)) == 0 && endsShortSyllable(s)
 }
 
-var step0Step = NewStep([]Suffix{
-	{"'s' 's '", Delete, nil, nil, nil},
+var step0Step = MustNewStep([]Suffix{
+	{Suffixes: "'s' 's '", Callback: Delete},
 })
 
-func step0(s []byte) []byte {
-	return step0Step.Apply(s)
+func step0(s []byte, t *Tracer) []byte {
+	return t.Hook(step0Step).Apply(s)
 }
 
 func step1aIed(s []byte, suffix []byte) []byte {
@@ -149,20 +152,20 @@ func step1aNothing(s []byte, suffix []byte) []byte {
 func step1aScb(s []byte, suffix []byte) []byte {
 	if len(s) > 2 &&
 		containsAny(s[:len(s)-2], vowels) {
-		s = s[:len(s)-1]
+		return s[:len(s)-1]
 	}
-	return s
+	return nil
 }
 
-var step1aStep = NewStep([]Suffix{
-	{"sses", Truncate("ss"), nil, nil, nil},
-	{"ied ies", step1aIed, nil, nil, nil},
-	{"s", step1aScb, nil, nil, nil},
-	{"us ss", step1aNothing, nil, nil, nil},
+var step1aStep = MustNewStep([]Suffix{
+	{Suffixes: "sses", Callback: Truncate("ss")},
+	{Suffixes: "ied ies", Callback: step1aIed},
+	{Suffixes: "s", Callback: step1aScb},
+	{Suffixes: "us ss", Callback: step1aNothing},
 })
 
-func step1a(s []byte) []byte {
-	return step1aStep.Apply(s)
+func step1a(s []byte, t *Tracer) []byte {
+	return t.Hook(step1aStep).Apply(s)
 }
 
 func step1bReplace(ss []byte, suffix []byte) []byte {
@@ -180,13 +183,13 @@ func step1bReplace(ss []byte, suffix []byte) []byte {
 	return ss
 }
 
-var step1bStep = NewStep([]Suffix{
-	{"eed eedly", Truncate("ee"), nil, r1, nil},
-	{"ed edly ing ingly", step1bReplace, nil, nil, nil},
+var step1bStep = MustNewStep([]Suffix{
+	{Suffixes: "eed eedly", Callback: Truncate("ee"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ed edly ing ingly", Callback: step1bReplace},
 })
 
-func step1b(s []byte) []byte {
-	return step1bStep.Apply(s)
+func step1b(s []byte, t *Tracer) []byte {
+	return t.Hook(step1bStep).Apply(s)
 }
 
 func step1c(s []byte) []byte {
@@ -200,7 +203,7 @@ func step2Ogi(s []byte, suffix []byte) []byte {
 	if len(s) > len(suffix) && s[len(s)-len(suffix)-1] == 'l' {
 		return s[:len(s)-1]
 	}
-	return s
+	return nil
 }
 
 func step2Li(s []byte, suffix []byte) []byte {
@@ -208,59 +211,59 @@ func step2Li(s []byte, suffix []byte) []byte {
 	if len(s) > len(suffix) && isAny(s[len(s)-len(suffix)-1], validLiEnding) {
 		return s[:len(s)-len(suffix)]
 	}
-	return s
-}
-
-var step2Step = NewStep([]Suffix{
-	{"tional", Truncate("tion"), nil, r1, nil},
-	{"enci", Replace("ence"), nil, r1, nil},
-	{"anci", Replace("ance"), nil, r1, nil},
-	{"abli", Replace("able"), nil, r1, nil},
-	{"entli", Truncate("ent"), nil, r1, nil},
-	{"izer ization", Replace("ize"), nil, r1, nil},
-	{"ational ation ator", Replace("ate"), nil, r1, nil},
-	{"alism aliti alli", Truncate("al"), nil, r1, nil},
-	{"fulness", Truncate("ful"), nil, r1, nil},
-	{"ousli ousness", Truncate("ous"), nil, r1, nil},
-	{"iveness iviti", Replace("ive"), nil, r1, nil},
-	{"biliti bli", Replace("ble"), nil, r1, nil},
-	{"ogi", step2Ogi, nil, r1, nil},
-	{"fulli", Truncate("ful"), nil, r1, nil},
-	{"lessli", Truncate("less"), nil, r1, nil},
-	{"li", step2Li, nil, r1, nil},
+	return nil
+}
+
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "tional", Callback: Truncate("tion"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "enci", Callback: Replace("ence"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "anci", Callback: Replace("ance"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "abli", Callback: Replace("able"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "entli", Callback: Truncate("ent"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "izer ization", Callback: Replace("ize"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ational ation ator", Callback: Replace("ate"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "alism aliti alli", Callback: Truncate("al"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "fulness", Callback: Truncate("ful"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ousli ousness", Callback: Truncate("ous"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "iveness iviti", Callback: Replace("ive"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "biliti bli", Callback: Replace("ble"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ogi", Callback: step2Ogi, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "fulli", Callback: Truncate("ful"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "lessli", Callback: Truncate("less"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "li", Callback: step2Li, ActionRegion: r1, Region: "R1"},
 })
 
-func step2(s []byte) []byte {
-	return step2Step.Apply(s)
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
 }
 
-var step3Step = NewStep([]Suffix{
-	{"tional", Replace("tion"), nil, r1, nil},
-	{"ational", Replace("ate"), nil, r1, nil},
-	{"alize", Replace("al"), nil, r1, nil},
-	{"icate iciti ical", Replace("ic"), nil, r1, nil},
-	{"ful ness", Delete, nil, r1, nil},
-	{"ative", Delete, nil, r2, nil},
+var step3Step = MustNewStep([]Suffix{
+	{Suffixes: "tional", Callback: Replace("tion"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ational", Callback: Replace("ate"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "alize", Callback: Replace("al"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "icate iciti ical", Callback: Replace("ic"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ful ness", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ative", Callback: Delete, ActionRegion: r2, Region: "R2"},
 })
 
-func step3(s []byte) []byte {
-	return step3Step.Apply(s)
+func step3(s []byte, t *Tracer) []byte {
+	return t.Hook(step3Step).Apply(s)
 }
 
 func step4Ion(s []byte, suffix []byte) []byte {
 	if len(s) > len(suffix) && isAny(s[len(s)-len(suffix)-1], "st") {
-		s = s[:len(s)-len(suffix)]
+		return s[:len(s)-len(suffix)]
 	}
-	return s
+	return nil
 }
 
-var step4Step = NewStep([]Suffix{
-	{"al ance ence er ic able ible ant ement ment ent ism ate iti ous ive ize", Delete, nil, r2, nil},
-	{"ion", step4Ion, nil, r2, nil},
+var step4Step = MustNewStep([]Suffix{
+	{Suffixes: "al ance ence er ic able ible ant ement ment ent ism ate iti ous ive ize", Callback: Delete, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "ion", Callback: step4Ion, ActionRegion: r2, Region: "R2"},
 })
 
-func step4(s []byte) []byte {
-	return step4Step.Apply(s)
+func step4(s []byte, t *Tracer) []byte {
+	return t.Hook(step4Step).Apply(s)
 }
 
 func lastIs(s []byte, b byte) bool {
@@ -320,16 +323,30 @@ var step1aInvariants = map[string]struct{}{
 }
 
 func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
 	if len(s) <= 2 {
 		return s
 	}
 	if s[0] == '\'' {
 		s = s[1:]
 	}
-	s = step0(s)
+	s = step0(s, t)
+	t.Record("step0", s)
 
 	if v, ok := specialWords[string(s)]; ok {
-		return []byte(v)
+		s = append(s[:0], v...)
+		t.Record("exception", s)
+		return s
 	}
 
 	for i := range s {
@@ -338,22 +355,31 @@ func StemBytes(s []byte) []byte {
 			break
 		}
 	}
+	t.Record("prelude", s)
 
-	s = step1a(s)
+	s = step1a(s, t)
+	t.Record("step1a", s)
 	if _, ok := step1aInvariants[string(s)]; ok {
 		return s
 	}
-	s = step1b(s)
+	s = step1b(s, t)
+	t.Record("step1b", s)
 	s = step1c(s)
-	s = step2(s)
-	s = step3(s)
-	s = step4(s)
+	t.Record("step1c", s)
+	s = step2(s, t)
+	t.Record("step2", s)
+	s = step3(s, t)
+	t.Record("step3", s)
+	s = step4(s, t)
+	t.Record("step4", s)
 	s = step5(s)
+	t.Record("step5", s)
 	for i := range s {
 		if s[i] == 'Y' {
 			s[i] = 'y'
 		}
 	}
+	t.Record("postlude", s)
 	return s
 }
 
@@ -362,7 +388,7 @@ func StemString(s string) string {
 }
 
 func NormalizeBytes(b []byte) []byte {
-	return bytes.ToLower(bytes.TrimSpace(b))
+	return textnorm.Bytes(b)
 }
 
 func NormalizeString(s string) string {
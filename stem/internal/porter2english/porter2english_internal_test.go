@@ -15,7 +15,11 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>. */
 
 package porter2english
 
-import "testing"
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
 
 func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
 	for i := 0; i < len(pairs); i += 2 {
@@ -27,6 +31,10 @@ func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
 
 }
 
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
 func TestR1(t *testing.T) {
 	pairs := []string{"beautiful", "iful", "beauty", "y", "beau", "", "animadversion", "imadversion", "sprinkled", "kled", "eucharist", "harist"}
 	test(t, r1, pairs)
@@ -39,12 +47,12 @@ func TestR2(t *testing.T) {
 func TestStep1a(t *testing.T) {
 	var pairs = []string{
 		"ties", "tie", "cries", "cri", "gas", "gas", "this", "this", "gaps", "gap", "kiwis", "kiwi"}
-	test(t, step1a, pairs)
+	test(t, untraced(step1a), pairs)
 }
 
 func TestStep1b(t *testing.T) {
 	var pairs = []string{"luxuriatingly", "luxuriate", "hopped", "hop", "hoping", "hope", "writing", "write"}
-	test(t, step1b, pairs)
+	test(t, untraced(step1b), pairs)
 }
 func TestStep1c(t *testing.T) {
 	var pairs = []string{"cry", "cri", "by", "by", "say", "say"}
@@ -53,5 +61,5 @@ func TestStep1c(t *testing.T) {
 
 func TestStep2(t *testing.T) {
 	var pairs = []string{"fepotional", "fepotion", "belogi", "belog"}
-	test(t, step2, pairs)
+	test(t, untraced(step2), pairs)
 }
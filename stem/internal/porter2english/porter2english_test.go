@@ -21,8 +21,8 @@ import (
 	"strings"
 	"testing"
 
-	"gitlab.com/gonlp/stem/internal/porter2english"
 	"gitlab.com/xojoc/util"
+	"xojoc.pw/nlp/stem/internal/porter2english"
 )
 
 func TestStemBytes(t *testing.T) {
@@ -0,0 +1,1103 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2english
+
+import (
+	"bytes"
+)
+
+func init() {
+	step0Step.SetDo(step0StepDo)
+	step1aStep.SetDo(step1aStepDo)
+	step1bStep.SetDo(step1bStepDo)
+	step2Step.SetDo(step2StepDo)
+	step3Step.SetDo(step3StepDo)
+	step4Step.SetDo(step4StepDo)
+}
+
+var step1aStepSuffixes = [...][]byte{
+	[]byte("ied"),
+	[]byte("ies"),
+	[]byte("ss"),
+	[]byte("us"),
+	[]byte("s"),
+}
+
+var step1bStepSuffixes = [...][]byte{
+	[]byte("eed"),
+	[]byte("ed"),
+	[]byte("ing"),
+	[]byte("eedly"),
+	[]byte("edly"),
+	[]byte("ingly"),
+}
+
+var step2StepSuffixes = [...][]byte{
+	[]byte("anci"),
+	[]byte("enci"),
+	[]byte("ogi"),
+	[]byte("abli"),
+	[]byte("bli"),
+	[]byte("alli"),
+	[]byte("fulli"),
+	[]byte("lessli"),
+	[]byte("ousli"),
+	[]byte("entli"),
+	[]byte("li"),
+	[]byte("aliti"),
+	[]byte("biliti"),
+	[]byte("iviti"),
+	[]byte("ational"),
+	[]byte("tional"),
+	[]byte("alism"),
+	[]byte("ization"),
+	[]byte("ation"),
+	[]byte("izer"),
+	[]byte("ator"),
+	[]byte("iveness"),
+	[]byte("fulness"),
+	[]byte("ousness"),
+}
+
+var step3StepSuffixes = [...][]byte{
+	[]byte("icate"),
+	[]byte("ative"),
+	[]byte("alize"),
+	[]byte("iciti"),
+	[]byte("ical"),
+	[]byte("ational"),
+	[]byte("tional"),
+	[]byte("ful"),
+	[]byte("ness"),
+}
+
+var step4StepSuffixes = [...][]byte{
+	[]byte("ic"),
+	[]byte("ance"),
+	[]byte("ence"),
+	[]byte("able"),
+	[]byte("ible"),
+	[]byte("ate"),
+	[]byte("ive"),
+	[]byte("ize"),
+	[]byte("iti"),
+	[]byte("al"),
+	[]byte("ism"),
+	[]byte("ion"),
+	[]byte("er"),
+	[]byte("ous"),
+	[]byte("ant"),
+	[]byte("ement"),
+	[]byte("ment"),
+	[]byte("ent"),
+}
+
+func step0StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0x27:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x27:
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0x27:
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1aStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := step1aIed(s, step1aStepSuffixes[0])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := step1aIed(s, step1aStepSuffixes[1])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									r := s[:len(s)-2]
+									return r, true
+								}
+							}
+						}
+					}
+				case 's':
+					r := step1aNothing(s, step1aStepSuffixes[2])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'u':
+					r := step1aNothing(s, step1aStepSuffixes[3])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+			r := step1aScb(s, step1aStepSuffixes[4])
+			if r == nil {
+				return s, false
+			}
+			return r, true
+		}
+	}
+	return s, false
+}
+
+func step1bStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if !bytes.HasSuffix(r1(s), step1bStepSuffixes[0]) {
+								return s, false
+							}
+							r := s[:len(s)-1]
+							return r, true
+						}
+					}
+					r := step1bReplace(s, step1bStepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := step1bReplace(s, step1bStepSuffixes[2])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'y':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if !bytes.HasSuffix(r1(s), step1bStepSuffixes[3]) {
+												return s, false
+											}
+											r := s[:len(s)-3]
+											return r, true
+										}
+									}
+									r := step1bReplace(s, step1bStepSuffixes[4])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						case 'g':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											r := step1bReplace(s, step1bStepSuffixes[5])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r1(s), step2StepSuffixes[0]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "ance"...)
+									return r, true
+								case 'e':
+									if !bytes.HasSuffix(r1(s), step2StepSuffixes[1]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "ence"...)
+									return r, true
+								}
+							}
+						}
+					}
+				case 'g':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r1(s), step2StepSuffixes[2]) {
+								return s, false
+							}
+							r := step2Ogi(s, step2StepSuffixes[2])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r1(s), step2StepSuffixes[3]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "able"...)
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r1(s), step2StepSuffixes[4]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "ble"...)
+							return r, true
+						case 'l':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r1(s), step2StepSuffixes[5]) {
+										return s, false
+									}
+									r := s[:len(s)-2]
+									return r, true
+								case 'u':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'f':
+											if !bytes.HasSuffix(r1(s), step2StepSuffixes[6]) {
+												return s, false
+											}
+											r := s[:len(s)-2]
+											return r, true
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'l':
+													if !bytes.HasSuffix(r1(s), step2StepSuffixes[7]) {
+														return s, false
+													}
+													r := s[:len(s)-2]
+													return r, true
+												}
+											}
+										}
+									}
+								case 'u':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'o':
+											if !bytes.HasSuffix(r1(s), step2StepSuffixes[8]) {
+												return s, false
+											}
+											r := s[:len(s)-2]
+											return r, true
+										}
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if !bytes.HasSuffix(r1(s), step2StepSuffixes[9]) {
+												return s, false
+											}
+											r := s[:len(s)-2]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+					if !bytes.HasSuffix(r1(s), step2StepSuffixes[10]) {
+						return s, false
+					}
+					r := step2Li(s, step2StepSuffixes[10])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r1(s), step2StepSuffixes[11]) {
+												return s, false
+											}
+											r := s[:len(s)-3]
+											return r, true
+										case 'i':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'b':
+													if !bytes.HasSuffix(r1(s), step2StepSuffixes[12]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "ble"...)
+													return r, true
+												}
+											}
+										}
+									}
+								case 'v':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r1(s), step2StepSuffixes[13]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "ive"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 't':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if !bytes.HasSuffix(r1(s), step2StepSuffixes[14]) {
+																return s, false
+															}
+															r := append(s[:len(s)-7], "ate"...)
+															return r, true
+														}
+													}
+													if !bytes.HasSuffix(r1(s), step2StepSuffixes[15]) {
+														return s, false
+													}
+													r := s[:len(s)-2]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r1(s), step2StepSuffixes[16]) {
+												return s, false
+											}
+											r := s[:len(s)-3]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'z':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'i':
+															if !bytes.HasSuffix(r1(s), step2StepSuffixes[17]) {
+																return s, false
+															}
+															r := append(s[:len(s)-7], "ize"...)
+															return r, true
+														}
+													}
+												}
+											}
+											if !bytes.HasSuffix(r1(s), step2StepSuffixes[18]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "ate"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'z':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r1(s), step2StepSuffixes[19]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "ize"...)
+									return r, true
+								}
+							}
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r1(s), step2StepSuffixes[20]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "ate"...)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'v':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'i':
+															if !bytes.HasSuffix(r1(s), step2StepSuffixes[21]) {
+																return s, false
+															}
+															r := append(s[:len(s)-7], "ive"...)
+															return r, true
+														}
+													}
+												}
+											}
+										case 'l':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'u':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'f':
+															if !bytes.HasSuffix(r1(s), step2StepSuffixes[22]) {
+																return s, false
+															}
+															r := s[:len(s)-4]
+															return r, true
+														}
+													}
+												}
+											}
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'u':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'o':
+															if !bytes.HasSuffix(r1(s), step2StepSuffixes[23]) {
+																return s, false
+															}
+															r := s[:len(s)-4]
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r1(s), step3StepSuffixes[0]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "ic"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step3StepSuffixes[1]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'z':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r1(s), step3StepSuffixes[2]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "al"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r1(s), step3StepSuffixes[3]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "ic"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r1(s), step3StepSuffixes[4]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "ic"...)
+									return r, true
+								}
+							}
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 't':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if !bytes.HasSuffix(r1(s), step3StepSuffixes[5]) {
+																return s, false
+															}
+															r := append(s[:len(s)-7], "ate"...)
+															return r, true
+														}
+													}
+													if !bytes.HasSuffix(r1(s), step3StepSuffixes[6]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "tion"...)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'f':
+							if !bytes.HasSuffix(r1(s), step3StepSuffixes[7]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if !bytes.HasSuffix(r1(s), step3StepSuffixes[8]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step4StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step4StepSuffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step4StepSuffixes[1]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'e':
+									if !bytes.HasSuffix(r2(s), step4StepSuffixes[2]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step4StepSuffixes[3]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step4StepSuffixes[4]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if !bytes.HasSuffix(r2(s), step4StepSuffixes[5]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step4StepSuffixes[6]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'z':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step4StepSuffixes[7]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step4StepSuffixes[8]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step4StepSuffixes[9]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step4StepSuffixes[10]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step4StepSuffixes[11]) {
+								return s, false
+							}
+							r := step4Ion(s, step4StepSuffixes[11])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if !bytes.HasSuffix(r2(s), step4StepSuffixes[12]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r2(s), step4StepSuffixes[13]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if !bytes.HasSuffix(r2(s), step4StepSuffixes[14]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'm':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if !bytes.HasSuffix(r2(s), step4StepSuffixes[15]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+									if !bytes.HasSuffix(r2(s), step4StepSuffixes[16]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r2(s), step4StepSuffixes[17]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
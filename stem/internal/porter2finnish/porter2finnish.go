@@ -0,0 +1,300 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2finnish
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/finnish/stemmer.html
+
+const vowels = "aeiouyäö"
+
+var (
+	r1 = R1(vowels)
+	r2 = R2(vowels)
+)
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune(vowels, r)
+}
+
+// before returns the part of s preceding suffix.
+func before(s []byte, suffix []byte) []byte {
+	return s[:len(s)-len(suffix)]
+}
+
+// endsIn reports whether the last rune of s is one of set.
+func endsIn(s []byte, set string) bool {
+	r, l := utf8.DecodeLastRune(s)
+	return l > 0 && strings.ContainsRune(set, r)
+}
+
+// endsAny reports whether s ends with one of the space separated suffixes.
+func endsAny(s []byte, suffixes string) bool {
+	for suffixes != "" {
+		x := suffixes
+		if i := strings.IndexByte(suffixes, ' '); i >= 0 {
+			x, suffixes = suffixes[:i], suffixes[i+1:]
+		} else {
+			suffixes = ""
+		}
+		if x != "" && bytes.HasSuffix(s, []byte(x)) {
+			return true
+		}
+	}
+	return false
+}
+
+const long = "aa ee ii oo uu ää öö"
+
+// endsVI reports whether s ends with i preceded by a vowel other than y.
+func endsVI(s []byte) bool {
+	return bytes.HasSuffix(s, []byte("i")) && endsIn(s[:len(s)-1], "aeiouäö")
+}
+
+// deleteAfter returns a callback which deletes the suffix only if it is
+// preceded by one of the space separated strings in prev.
+func deleteAfter(prev string) func([]byte, []byte) []byte {
+	return func(s []byte, suffix []byte) []byte {
+		if endsAny(before(s, suffix), prev) {
+			return Delete(s, suffix)
+		}
+		return nil
+	}
+}
+
+// deleteNotAfter is the opposite of deleteAfter.
+func deleteNotAfter(prev string) func([]byte, []byte) []byte {
+	return func(s []byte, suffix []byte) []byte {
+		if !endsAny(before(s, suffix), prev) {
+			return Delete(s, suffix)
+		}
+		return nil
+	}
+}
+
+func deleteParticle(s []byte, suffix []byte) []byte {
+	if endsIn(before(s, suffix), vowels+"nt") {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+var particleStep = MustNewStep([]Suffix{
+	{Suffixes: "kin kaan kään ko kö han hän pa pä", Callback: deleteParticle, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "sti", Callback: Delete, MatchRegion: r1, ActionRegion: r2, Region: "R2"},
+})
+
+func particle(s []byte, t *Tracer) []byte {
+	return t.Hook(particleStep).Apply(s)
+}
+
+var possessiveStep = MustNewStep([]Suffix{
+	{Suffixes: "si", Callback: deleteNotAfter("k"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "ni", Callback: Delete, MatchRegion: r1, Region: "R1", SuffixCallbacks: []Suffix{{Suffixes: "kse", Callback: Replace("ksi")}}},
+	{Suffixes: "nsa nsä mme nne", Callback: Delete, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "an", Callback: deleteAfter("ta ssa sta lla lta na"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "än", Callback: deleteAfter("tä ssä stä llä ltä nä"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "en", Callback: deleteAfter("lle ine"), MatchRegion: r1, Region: "R1"},
+})
+
+func possessive(s []byte, t *Tracer) []byte {
+	return t.Hook(possessiveStep).Apply(s)
+}
+
+// inR1 returns the part of R1 preceding suffix.
+func inR1(s []byte, suffix []byte) []byte {
+	r := r1(s)
+	if len(r) < len(suffix) {
+		return nil
+	}
+	return r[:len(r)-len(suffix)]
+}
+
+// deleteVI deletes the suffix if it follows i and a vowel in R1.
+// Otherwise the final n is handled like a genitive.
+func deleteVI(s []byte, suffix []byte) []byte {
+	if endsVI(inR1(s, suffix)) {
+		return Delete(s, suffix)
+	}
+	return deleteN(s, s[len(s)-1:])
+}
+
+// deleteLong deletes the suffix if it follows a long vowel in R1.
+// Otherwise the final n is handled like a genitive.
+func deleteLong(s []byte, suffix []byte) []byte {
+	if endsAny(inR1(s, suffix), long) {
+		return Delete(s, suffix)
+	}
+	return deleteN(s, s[len(s)-1:])
+}
+
+// deleteN deletes the n of genitives and illatives. After a long vowel or
+// ie the last vowel goes too.
+func deleteN(s []byte, suffix []byte) []byte {
+	s = Delete(s, suffix)
+	if endsAny(s, long+" ie") {
+		_, l := utf8.DecodeLastRune(s)
+		s = s[:len(s)-l]
+	}
+	return s
+}
+
+// deletePartitive deletes a final a or ä preceded by a vowel and a
+// non-vowel.
+func deletePartitive(s []byte, suffix []byte) []byte {
+	p := before(s, suffix)
+	_, l := utf8.DecodeLastRune(p)
+	if q := p[:len(p)-l]; endsIn(p, vowels) && len(q) > 0 && !endsIn(q, vowels) {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+var caseStep = MustNewStep([]Suffix{
+	{Suffixes: "han", Callback: deleteAfter("a"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "hen", Callback: deleteAfter("e"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "hin", Callback: deleteAfter("i"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "hon", Callback: deleteAfter("o"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "hän", Callback: deleteAfter("ä"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "hön", Callback: deleteAfter("ö"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "siin den tten", Callback: deleteVI, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "seen", Callback: deleteLong, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "n", Callback: deleteN, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "a ä", Callback: deletePartitive, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "tta ttä", Callback: deleteAfter("e"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "ta tä ssa ssä sta stä lla llä lta ltä lle na nä ksi ine", Callback: Delete, MatchRegion: r1, Region: "R1"},
+})
+
+// caseEnding removes a case ending and reports whether it did so.
+func caseEnding(s []byte, t *Tracer) ([]byte, bool) {
+	return t.Hook(caseStep).Do(s)
+}
+
+var otherStep = MustNewStep([]Suffix{
+	{Suffixes: "mpi mpa mpä mmi mma mmä", Callback: deleteNotAfter("po"), MatchRegion: r2, Region: "R2"},
+	{Suffixes: "impi impa impä immi imma immä eja ejä", Callback: Delete, MatchRegion: r2, Region: "R2"},
+})
+
+func otherEndings(s []byte, t *Tracer) []byte {
+	return t.Hook(otherStep).Apply(s)
+}
+
+var iPluralStep = MustNewStep([]Suffix{
+	{Suffixes: "i j", Callback: Delete, MatchRegion: r1, Region: "R1"},
+})
+
+func iPlural(s []byte, t *Tracer) []byte {
+	return t.Hook(iPluralStep).Apply(s)
+}
+
+var tPluralStep = MustNewStep([]Suffix{
+	{Suffixes: "mma", Callback: deleteNotAfter("po"), MatchRegion: r2, Region: "R2"},
+	{Suffixes: "imma", Callback: Delete, MatchRegion: r2, Region: "R2"},
+})
+
+// tPlural removes a plural t preceded by a vowel, both in R1, and then
+// the comparative mma.
+func tPlural(s []byte, t *Tracer) []byte {
+	if !bytes.HasSuffix(s, []byte("t")) {
+		return s
+	}
+	_, l := utf8.DecodeLastRune(s[:len(s)-1])
+	if !endsIn(s[:len(s)-1], vowels) || len(r1(s)) < l+1 {
+		return s
+	}
+	return t.Hook(tPluralStep).Apply(s[:len(s)-1])
+}
+
+func tidy(s []byte) []byte {
+	if endsAny(r1(s), long) {
+		_, l := utf8.DecodeLastRune(s)
+		s = s[:len(s)-l]
+	}
+	if r := r1(s); endsIn(r, "aäei") {
+		_, l := utf8.DecodeLastRune(r)
+		if q := r[:len(r)-l]; len(q) > 0 && !endsIn(q, vowels) {
+			s = s[:len(s)-l]
+		}
+	}
+	if endsAny(r1(s), "oj uj") {
+		s = s[:len(s)-1]
+	}
+	if endsAny(r1(s), "jo") {
+		s = s[:len(s)-1]
+	}
+	// undouble the last non-vowel, wherever it is
+	i := bytes.LastIndexFunc(s, func(r rune) bool { return !isVowel(r) })
+	if i > 0 {
+		_, l := utf8.DecodeRune(s[i:])
+		if bytes.HasSuffix(s[:i], s[i:i+l]) {
+			s = append(s[:i], s[i+l:]...)
+		}
+	}
+	return s
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = particle(s, t)
+	t.Record("particle", s)
+	s = possessive(s, t)
+	t.Record("possessive", s)
+	s, removed := caseEnding(s, t)
+	t.Record("caseEnding", s)
+	s = otherEndings(s, t)
+	t.Record("otherEndings", s)
+	if removed {
+		s = iPlural(s, t)
+		t.Record("iPlural", s)
+	} else {
+		s = tPlural(s, t)
+		t.Record("tPlural", s)
+	}
+	s = tidy(s)
+	t.Record("tidy", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
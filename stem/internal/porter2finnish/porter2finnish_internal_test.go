@@ -0,0 +1,75 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2finnish
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestR1(t *testing.T) {
+	pairs := []string{"kirjassa", "jassa", "aalloilla", "loilla", "isä", "ä"}
+	test(t, r1, pairs)
+}
+
+func TestParticle(t *testing.T) {
+	pairs := []string{"talossakin", "talossa", "pojallekin", "pojalle", "helposti", "helposti", "kirjako", "kirja", "kirjasko", "kirjasko"}
+	test(t, untraced(particle), pairs)
+}
+
+func TestPossessive(t *testing.T) {
+	pairs := []string{"talossaan", "talossa", "ystävämme", "ystävä", "isänsä", "isä", "kirjoikseni", "kirjoiksi", "kirjasi", "kirja", "kaksi", "kaksi"}
+	test(t, untraced(possessive), pairs)
+}
+
+func TestCaseEnding(t *testing.T) {
+	pairs := []string{"taloon", "talo", "maahan", "maaha", "huoneeseen", "huonee", "taloa", "talo", "ajatuksia", "ajatuksi", "kirjassa", "kirja", "lapsen", "lapse", "työntekijöiden", "työntekijöi"}
+	fn := func(s []byte) []byte {
+		s, _ = caseEnding(s, nil)
+		return s
+	}
+	test(t, fn, pairs)
+}
+
+func TestOtherEndings(t *testing.T) {
+	pairs := []string{"kauniimpi", "kauniimpi", "suurimpi", "suurimpi", "kirjoittaja", "kirjoittaja", "laulajaeja", "laulaja"}
+	test(t, untraced(otherEndings), pairs)
+}
+
+func TestTPlural(t *testing.T) {
+	pairs := []string{"kalat", "kala", "kysymykset", "kysymykse", "at", "at"}
+	test(t, untraced(tPlural), pairs)
+}
+
+func TestTidy(t *testing.T) {
+	pairs := []string{"huonee", "huone", "kirja", "kirj", "katto", "kato", "kala", "kala", "kirjoj", "kirj"}
+	test(t, tidy, pairs)
+}
@@ -0,0 +1,996 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2finnish
+
+import (
+	"bytes"
+)
+
+func init() {
+	caseStep.SetDo(caseStepDo)
+	iPluralStep.SetDo(iPluralStepDo)
+	otherStep.SetDo(otherStepDo)
+	particleStep.SetDo(particleStepDo)
+	possessiveStep.SetDo(possessiveStepDo)
+	tPluralStep.SetDo(tPluralStepDo)
+}
+
+var caseStepCallbacks = [...]func([]byte, []byte) []byte{
+	deleteAfter("e"),
+	deleteAfter("a"),
+	deleteAfter("i"),
+	deleteAfter("o"),
+	deleteAfter("ä"),
+	deleteAfter("ö"),
+}
+
+var caseStepSuffixes = [...][]byte{
+	[]byte("lla"),
+	[]byte("na"),
+	[]byte("ssa"),
+	[]byte("lta"),
+	[]byte("sta"),
+	[]byte("tta"),
+	[]byte("ta"),
+	[]byte("a"),
+	[]byte("lle"),
+	[]byte("ine"),
+	[]byte("ksi"),
+	[]byte("han"),
+	[]byte("den"),
+	[]byte("seen"),
+	[]byte("hen"),
+	[]byte("tten"),
+	[]byte("hin"),
+	[]byte("siin"),
+	[]byte("hon"),
+	[]byte("hän"),
+	[]byte("hön"),
+	[]byte("n"),
+	[]byte("llä"),
+	[]byte("nä"),
+	[]byte("ssä"),
+	[]byte("ltä"),
+	[]byte("stä"),
+	[]byte("ttä"),
+	[]byte("tä"),
+	[]byte("ä"),
+}
+
+var iPluralStepSuffixes = [...][]byte{
+	[]byte("i"),
+	[]byte("j"),
+}
+
+var otherStepCallbacks = [...]func([]byte, []byte) []byte{
+	deleteNotAfter("po"),
+}
+
+var otherStepSuffixes = [...][]byte{
+	[]byte("eja"),
+	[]byte("imma"),
+	[]byte("mma"),
+	[]byte("impa"),
+	[]byte("mpa"),
+	[]byte("immi"),
+	[]byte("mmi"),
+	[]byte("impi"),
+	[]byte("mpi"),
+	[]byte("ejä"),
+	[]byte("immä"),
+	[]byte("mmä"),
+	[]byte("impä"),
+	[]byte("mpä"),
+}
+
+var particleStepSuffixes = [...][]byte{
+	[]byte("pa"),
+	[]byte("sti"),
+	[]byte("kaan"),
+	[]byte("han"),
+	[]byte("kin"),
+	[]byte("hän"),
+	[]byte("kään"),
+	[]byte("ko"),
+	[]byte("pä"),
+	[]byte("kö"),
+}
+
+var possessiveStepCallbacks = [...]func([]byte, []byte) []byte{
+	deleteNotAfter("k"),
+	deleteAfter("ta ssa sta lla lta na"),
+	deleteAfter("lle ine"),
+	deleteAfter("tä ssä stä llä ltä nä"),
+}
+
+var possessiveStepSuffixes = [...][]byte{
+	[]byte("nsa"),
+	[]byte("mme"),
+	[]byte("nne"),
+	[]byte("ni"),
+	[]byte("si"),
+	[]byte("an"),
+	[]byte("en"),
+	[]byte("än"),
+	[]byte("nsä"),
+}
+
+var tPluralStepCallbacks = [...]func([]byte, []byte) []byte{
+	deleteNotAfter("po"),
+}
+
+var tPluralStepSuffixes = [...][]byte{
+	[]byte("imma"),
+	[]byte("mma"),
+}
+
+func caseStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[0]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'n':
+					if bytes.HasSuffix(r1(s), caseStepSuffixes[1]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[2]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[3]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 's':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[4]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 't':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[5]) {
+								r := caseStepCallbacks[0](s, caseStepSuffixes[5])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), caseStepSuffixes[6]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+			if bytes.HasSuffix(r1(s), caseStepSuffixes[7]) {
+				r := deletePartitive(s, caseStepSuffixes[7])
+				if r == nil {
+					return s, false
+				}
+				return r, true
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[8]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[9]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'k':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[10]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'h':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[11]) {
+								r := caseStepCallbacks[1](s, caseStepSuffixes[11])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[12]) {
+								r := deleteVI(s, caseStepSuffixes[12])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[13]) {
+										r := deleteLong(s, caseStepSuffixes[13])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 'h':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[14]) {
+								r := caseStepCallbacks[0](s, caseStepSuffixes[14])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[15]) {
+										r := deleteVI(s, caseStepSuffixes[15])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'h':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[16]) {
+								r := caseStepCallbacks[2](s, caseStepSuffixes[16])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[17]) {
+										r := deleteVI(s, caseStepSuffixes[17])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'h':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[18]) {
+								r := caseStepCallbacks[3](s, caseStepSuffixes[18])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 0xa4:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'h':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[19]) {
+										r := caseStepCallbacks[4](s, caseStepSuffixes[19])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 0xb6:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'h':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[20]) {
+										r := caseStepCallbacks[5](s, caseStepSuffixes[20])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+			if bytes.HasSuffix(r1(s), caseStepSuffixes[21]) {
+				r := deleteN(s, caseStepSuffixes[21])
+				if r == nil {
+					return s, false
+				}
+				return r, true
+			}
+		case 0xa4:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[22]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'n':
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[23]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[24]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[25]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 's':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[26]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 't':
+									if bytes.HasSuffix(r1(s), caseStepSuffixes[27]) {
+										r := caseStepCallbacks[0](s, caseStepSuffixes[27])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+							if bytes.HasSuffix(r1(s), caseStepSuffixes[28]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), caseStepSuffixes[29]) {
+						r := deletePartitive(s, caseStepSuffixes[29])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func iPluralStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'i':
+			if bytes.HasSuffix(r1(s), iPluralStepSuffixes[0]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		case 'j':
+			if bytes.HasSuffix(r1(s), iPluralStepSuffixes[1]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		}
+	}
+	return s, false
+}
+
+func otherStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'j':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if bytes.HasSuffix(r2(s), otherStepSuffixes[0]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(r2(s), otherStepSuffixes[1]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+							if bytes.HasSuffix(r2(s), otherStepSuffixes[2]) {
+								r := otherStepCallbacks[0](s, otherStepSuffixes[2])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'p':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(r2(s), otherStepSuffixes[3]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+							if bytes.HasSuffix(r2(s), otherStepSuffixes[4]) {
+								r := otherStepCallbacks[0](s, otherStepSuffixes[4])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(r2(s), otherStepSuffixes[5]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+							if bytes.HasSuffix(r2(s), otherStepSuffixes[6]) {
+								r := otherStepCallbacks[0](s, otherStepSuffixes[6])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'p':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(r2(s), otherStepSuffixes[7]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+							if bytes.HasSuffix(r2(s), otherStepSuffixes[8]) {
+								r := otherStepCallbacks[0](s, otherStepSuffixes[8])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 0xa4:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'j':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r2(s), otherStepSuffixes[9]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'm':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(r2(s), otherStepSuffixes[10]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+									if bytes.HasSuffix(r2(s), otherStepSuffixes[11]) {
+										r := otherStepCallbacks[0](s, otherStepSuffixes[11])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 'p':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'm':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(r2(s), otherStepSuffixes[12]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+									if bytes.HasSuffix(r2(s), otherStepSuffixes[13]) {
+										r := otherStepCallbacks[0](s, otherStepSuffixes[13])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func particleStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'p':
+					if bytes.HasSuffix(r1(s), particleStepSuffixes[0]) {
+						r := deleteParticle(s, particleStepSuffixes[0])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if bytes.HasSuffix(r1(s), particleStepSuffixes[1]) {
+								if !bytes.HasSuffix(r2(s), particleStepSuffixes[1]) {
+									return s, false
+								}
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'k':
+									if bytes.HasSuffix(r1(s), particleStepSuffixes[2]) {
+										r := deleteParticle(s, particleStepSuffixes[2])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 'h':
+							if bytes.HasSuffix(r1(s), particleStepSuffixes[3]) {
+								r := deleteParticle(s, particleStepSuffixes[3])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'k':
+							if bytes.HasSuffix(r1(s), particleStepSuffixes[4]) {
+								r := deleteParticle(s, particleStepSuffixes[4])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 0xa4:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'h':
+									if bytes.HasSuffix(r1(s), particleStepSuffixes[5]) {
+										r := deleteParticle(s, particleStepSuffixes[5])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 0xa4:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'k':
+													if bytes.HasSuffix(r1(s), particleStepSuffixes[6]) {
+														r := deleteParticle(s, particleStepSuffixes[6])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'k':
+					if bytes.HasSuffix(r1(s), particleStepSuffixes[7]) {
+						r := deleteParticle(s, particleStepSuffixes[7])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 0xa4:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'p':
+							if bytes.HasSuffix(r1(s), particleStepSuffixes[8]) {
+								r := deleteParticle(s, particleStepSuffixes[8])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 0xb6:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'k':
+							if bytes.HasSuffix(r1(s), particleStepSuffixes[9]) {
+								r := deleteParticle(s, particleStepSuffixes[9])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func possessiveStepNested1Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'k':
+							r := append(s[:len(s)-3], "ksi"...)
+							return r, true
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func possessiveStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if bytes.HasSuffix(r1(s), possessiveStepSuffixes[0]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							if bytes.HasSuffix(r1(s), possessiveStepSuffixes[1]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if bytes.HasSuffix(r1(s), possessiveStepSuffixes[2]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if bytes.HasSuffix(r1(s), possessiveStepSuffixes[3]) {
+						r := s[:len(s)-2]
+						r, _ = possessiveStepNested1Do(r)
+						return r, true
+					}
+				case 's':
+					if bytes.HasSuffix(r1(s), possessiveStepSuffixes[4]) {
+						r := possessiveStepCallbacks[0](s, possessiveStepSuffixes[4])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if bytes.HasSuffix(r1(s), possessiveStepSuffixes[5]) {
+						r := possessiveStepCallbacks[1](s, possessiveStepSuffixes[5])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'e':
+					if bytes.HasSuffix(r1(s), possessiveStepSuffixes[6]) {
+						r := possessiveStepCallbacks[2](s, possessiveStepSuffixes[6])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 0xa4:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if bytes.HasSuffix(r1(s), possessiveStepSuffixes[7]) {
+								r := possessiveStepCallbacks[3](s, possessiveStepSuffixes[7])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 0xa4:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if bytes.HasSuffix(r1(s), possessiveStepSuffixes[8]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func tPluralStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(r2(s), tPluralStepSuffixes[0]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+							if bytes.HasSuffix(r2(s), tPluralStepSuffixes[1]) {
+								r := tPluralStepCallbacks[0](s, tPluralStepSuffixes[1])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
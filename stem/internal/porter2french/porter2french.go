@@ -0,0 +1,334 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2french
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/french/stemmer.html
+
+const vowels = "aeiouyâàëéêèïîôûù"
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune(vowels, r)
+}
+
+var (
+	r1 = R1(vowels)
+	r2 = R2(vowels)
+	rv = FrenchRV(vowels)
+)
+
+// inRegion reports whether the n bytes before suffix are also inside region.
+func inRegion(region func([]byte) []byte, s []byte, suffix []byte, n int) bool {
+	return len(region(s)) >= len(suffix)+n
+}
+
+// before returns the rune preceding suffix and its size.
+func before(s []byte, suffix []byte) (rune, int) {
+	return utf8.DecodeLastRune(s[:len(s)-len(suffix)])
+}
+
+// normalize marks the vowels to be treated as consonants. Like the
+// Snowball "repeat goto", it tries again at the same position after each
+// change.
+func normalize(s []byte) []byte {
+	for i := 0; i < len(s); {
+		r, l := utf8.DecodeRune(s[i:])
+		j := i + l
+		if isVowel(r) && j < len(s) {
+			switch s[j] {
+			case 'u', 'i':
+				if nr, _ := utf8.DecodeRune(s[j+1:]); isVowel(nr) {
+					s[j] = s[j] - 'a' + 'A'
+					continue
+				}
+			case 'y':
+				s[j] = 'Y'
+				continue
+			}
+		}
+		if r == 'y' {
+			if nr, _ := utf8.DecodeRune(s[j:]); isVowel(nr) {
+				s[i] = 'Y'
+				continue
+			}
+		}
+		if r == 'q' && j < len(s) && s[j] == 'u' {
+			s[j] = 'U'
+			continue
+		}
+		i = j
+	}
+	return s
+}
+
+func r2DeleteOr(by string) func([]byte, []byte) []byte {
+	replace := Replace(by)
+	return func(s []byte, suffix []byte) []byte {
+		if inRegion(r2, s, suffix, 0) {
+			return Delete(s, suffix)
+		}
+		return replace(s, suffix)
+	}
+}
+
+func step1Eus(s []byte, suffix []byte) []byte {
+	if inRegion(r2, s, suffix, 0) {
+		return Delete(s, suffix)
+	} else if inRegion(r1, s, suffix, 0) {
+		return Replace("eux")(s, suffix)
+	}
+	return nil
+}
+
+func step1Issement(s []byte, suffix []byte) []byte {
+	if r, l := before(s, suffix); l > 0 && !isVowel(r) {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+func step1Ment(s []byte, suffix []byte) []byte {
+	if r, l := before(s, suffix); l > 0 && isVowel(r) && inRegion(rv, s, suffix, l) {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "ance iqUe isme able iste eux ances iqUes ismes ables istes", Callback: Delete, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "atrice ateur ation atrices ateurs ations", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "ic", Callback: r2DeleteOr("iqU")}}},
+	{Suffixes: "logie logies", Callback: Replace("log"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "usion ution usions utions", Callback: Truncate("u"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "ence ences", Callback: Replace("ent"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "ement ements", Callback: Delete, ActionRegion: rv, Region: "RV",
+		SuffixCallbacks: []Suffix{
+			{Suffixes: "iv", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "at", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+			{Suffixes: "eus", Callback: step1Eus},
+			{Suffixes: "abl iqU", Callback: Delete, ActionRegion: r2, Region: "R2"},
+			{Suffixes: "ièr Ièr", Callback: Replace("i"), ActionRegion: rv, Region: "RV"},
+		},
+	},
+	{Suffixes: "ité ités", Callback: Delete, ActionRegion: r2, Region: "R2",
+		SuffixCallbacks: []Suffix{
+			{Suffixes: "abil", Callback: r2DeleteOr("abl")},
+			{Suffixes: "ic", Callback: r2DeleteOr("iqU")},
+			{Suffixes: "iv", Callback: Delete, ActionRegion: r2, Region: "R2"},
+		},
+	},
+	{Suffixes: "if ive ifs ives", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "at", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "ic", Callback: r2DeleteOr("iqU")}}}}},
+	{Suffixes: "eaux", Callback: Truncate("eau")},
+	{Suffixes: "aux", Callback: Replace("al"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "euse euses", Callback: step1Eus},
+	{Suffixes: "issement issements", Callback: step1Issement, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "amment", Callback: Replace("ant"), ActionRegion: rv, Region: "RV"},
+	{Suffixes: "emment", Callback: Replace("ent"), ActionRegion: rv, Region: "RV"},
+	{Suffixes: "ment ments", Callback: step1Ment},
+})
+
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
+}
+
+// endsMent reports whether the suffix removed by step1 (if any) is one
+// of amment, emment, ment and ments. After these step2a is still done.
+func endsMent(s []byte) bool {
+	return (bytes.HasSuffix(s, []byte("ment")) || bytes.HasSuffix(s, []byte("ments"))) &&
+		!bytes.HasSuffix(s, []byte("ement")) && !bytes.HasSuffix(s, []byte("ements"))
+}
+
+func step2aCB(s []byte, suffix []byte) []byte {
+	if r, l := before(s, suffix); l > 0 && !isVowel(r) && inRegion(rv, s, suffix, l) {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+var step2aStep = MustNewStep([]Suffix{
+	{Suffixes: "îmes ît îtes i ie ies ir ira irai iraIent irais irait iras irent irez iriez irions irons iront is issaIent issais issait issant issante issantes issants isse issent isses issez issiez issions issons it", Callback: step2aCB, MatchRegion: rv, Region: "RV"},
+})
+
+func step2a(s []byte, t *Tracer) []byte {
+	return t.Hook(step2aStep).Apply(s)
+}
+
+var step2bStep = MustNewStep([]Suffix{
+	{Suffixes: "ions", Callback: Delete, MatchRegion: rv, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "é ée ées és èrent er era erai eraIent erais erait eras erez eriez erions erons eront ez iez", Callback: Delete, MatchRegion: rv, Region: "RV"},
+	{Suffixes: "âmes ât âtes a ai aIent ais ait ant ante antes ants as asse assent asses assiez assions", Callback: Delete, MatchRegion: rv, Region: "RV", SuffixCallbacks: []Suffix{{Suffixes: "e", Callback: Delete, MatchRegion: rv, Region: "RV"}}},
+})
+
+func step2b(s []byte, t *Tracer) []byte {
+	return t.Hook(step2bStep).Apply(s)
+}
+
+func step3(s []byte) []byte {
+	if bytes.HasSuffix(s, []byte("Y")) {
+		s[len(s)-1] = 'i'
+	} else if bytes.HasSuffix(s, []byte("ç")) {
+		s = Replace("c")(s, []byte("ç"))
+	}
+	return s
+}
+
+func step4S(s []byte) []byte {
+	if !bytes.HasSuffix(s, []byte("s")) {
+		return s
+	}
+	if r, l := before(s, []byte("s")); l > 0 && !strings.ContainsRune("aiouès", r) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+func step4Ion(s []byte, suffix []byte) []byte {
+	if inRegion(rv, s, suffix, 1) {
+		if b := s[len(s)-len(suffix)-1]; b == 's' || b == 't' {
+			return Delete(s, suffix)
+		}
+	}
+	return nil
+}
+
+func step4Gu(s []byte, suffix []byte) []byte {
+	if inRegion(rv, s, suffix, 2) && bytes.HasSuffix(s[:len(s)-len(suffix)], []byte("gu")) {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+var step4Step = MustNewStep([]Suffix{
+	{Suffixes: "ion", Callback: step4Ion, MatchRegion: rv, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "ier ière Ier Ière", Callback: Replace("i"), MatchRegion: rv, Region: "RV"},
+	{Suffixes: "e", Callback: Delete, MatchRegion: rv, Region: "RV"},
+	{Suffixes: "ë", Callback: step4Gu, MatchRegion: rv, Region: "RV"},
+})
+
+func step4(s []byte, t *Tracer) []byte {
+	s = step4S(s)
+	return t.Hook(step4Step).Apply(s)
+}
+
+func undouble(s []byte, suffix []byte) []byte {
+	return s[:len(s)-1]
+}
+
+var step5Step = MustNewStep([]Suffix{
+	{Suffixes: "enn onn ett ell eill", Callback: undouble},
+})
+
+func step5(s []byte, t *Tracer) []byte {
+	return t.Hook(step5Step).Apply(s)
+}
+
+func step6(s []byte) []byte {
+	i := len(s)
+	for i > 0 {
+		r, l := utf8.DecodeLastRune(s[:i])
+		if isVowel(r) {
+			break
+		}
+		i -= l
+	}
+	if i == len(s) || i == 0 {
+		return s
+	}
+	r, l := utf8.DecodeLastRune(s[:i])
+	if r == 'é' || r == 'è' {
+		s[i-l] = 'e'
+		copy(s[i-l+1:], s[i:])
+		s = s[:len(s)-l+1]
+	}
+	return s
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = normalize(s)
+	t.Record("normalize", s)
+	n := len(s)
+	ment := endsMent(s)
+	s = step1(s, t)
+	t.Record("step1", s)
+	changed := len(s) != n && !ment
+	if !changed {
+		n = len(s)
+		s = step2a(s, t)
+		t.Record("step2a", s)
+		if len(s) == n {
+			s = step2b(s, t)
+			t.Record("step2b", s)
+		}
+		changed = len(s) != n
+	}
+	if changed {
+		s = step3(s)
+		t.Record("step3", s)
+	} else {
+		s = step4(s, t)
+		t.Record("step4", s)
+	}
+	s = step5(s, t)
+	t.Record("step5", s)
+	s = step6(s)
+	t.Record("step6", s)
+	for i, b := range s {
+		switch b {
+		case 'I':
+			s[i] = 'i'
+		case 'U':
+			s[i] = 'u'
+		case 'Y':
+			s[i] = 'y'
+		}
+	}
+	t.Record("postlude", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+// form keeps œ and æ, which are letters of French words like cœur.
+var form = textnorm.Form{Letters: "œæ"}
+
+func NormalizeBytes(b []byte) []byte {
+	return form.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
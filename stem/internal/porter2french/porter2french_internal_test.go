@@ -0,0 +1,71 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2french
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestNormalize(t *testing.T) {
+	pairs := []string{"jouer", "joUer", "ennuie", "ennuIe", "yeux", "Yeux", "quand", "qUand", "croyiez", "croYiez"}
+	test(t, normalize, pairs)
+}
+
+func TestR1(t *testing.T) {
+	pairs := []string{"fameusement", "eusement", "été", "é", "élever", "ever"}
+	test(t, r1, pairs)
+}
+
+func TestR2(t *testing.T) {
+	pairs := []string{"fameusement", "ement", "élever", "er"}
+	test(t, r2, pairs)
+}
+
+func TestRv(t *testing.T) {
+	pairs := []string{"aimer", "er", "adorer", "rer", "voler", "ler", "tapis", "is", "ai", ""}
+	test(t, rv, pairs)
+}
+
+func TestStep1(t *testing.T) {
+	pairs := []string{"majestueusement", "majestu", "chevaux", "cheval", "bateaux", "bateau"}
+	test(t, untraced(step1), pairs)
+}
+
+func TestStep5(t *testing.T) {
+	pairs := []string{"mainten", "mainten", "consonn", "conson", "pareill", "pareil"}
+	test(t, untraced(step5), pairs)
+}
+
+func TestStep6(t *testing.T) {
+	pairs := []string{"malhonnêt", "malhonnêt", "malheur", "malheur", "modél", "model", "rè", "rè"}
+	test(t, step6, pairs)
+}
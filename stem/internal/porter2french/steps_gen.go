@@ -0,0 +1,2503 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2french
+
+import (
+	"bytes"
+)
+
+func init() {
+	step1Step.SetDo(step1StepDo)
+	step2aStep.SetDo(step2aStepDo)
+	step2bStep.SetDo(step2bStepDo)
+	step4Step.SetDo(step4StepDo)
+	step5Step.SetDo(step5StepDo)
+}
+
+var step1StepNested1Callbacks = [...]func([]byte, []byte) []byte{
+	r2DeleteOr("iqU"),
+}
+
+var step1StepNested1Suffixes = [...][]byte{
+	[]byte("ic"),
+}
+
+var step1StepNested5Nested0Suffixes = [...][]byte{
+	[]byte("at"),
+}
+
+var step1StepNested5Suffixes = [...][]byte{
+	[]byte("iqU"),
+	[]byte("abl"),
+	[]byte("Ièr"),
+	[]byte("ièr"),
+	[]byte("eus"),
+	[]byte("iv"),
+}
+
+var step1StepNested6Callbacks = [...]func([]byte, []byte) []byte{
+	r2DeleteOr("iqU"),
+	r2DeleteOr("abl"),
+}
+
+var step1StepNested6Suffixes = [...][]byte{
+	[]byte("ic"),
+	[]byte("abil"),
+	[]byte("iv"),
+}
+
+var step1StepNested7Nested0Callbacks = [...]func([]byte, []byte) []byte{
+	r2DeleteOr("iqU"),
+}
+
+var step1StepNested7Nested0Suffixes = [...][]byte{
+	[]byte("ic"),
+}
+
+var step1StepNested7Suffixes = [...][]byte{
+	[]byte("at"),
+}
+
+var step1StepSuffixes = [...][]byte{
+	[]byte("iqUe"),
+	[]byte("atrice"),
+	[]byte("ance"),
+	[]byte("ence"),
+	[]byte("logie"),
+	[]byte("able"),
+	[]byte("isme"),
+	[]byte("euse"),
+	[]byte("iste"),
+	[]byte("ive"),
+	[]byte("if"),
+	[]byte("usion"),
+	[]byte("ation"),
+	[]byte("ution"),
+	[]byte("ateur"),
+	[]byte("iqUes"),
+	[]byte("atrices"),
+	[]byte("ances"),
+	[]byte("ences"),
+	[]byte("logies"),
+	[]byte("ables"),
+	[]byte("ismes"),
+	[]byte("euses"),
+	[]byte("istes"),
+	[]byte("ives"),
+	[]byte("ifs"),
+	[]byte("usions"),
+	[]byte("ations"),
+	[]byte("utions"),
+	[]byte("ateurs"),
+	[]byte("issements"),
+	[]byte("ements"),
+	[]byte("ments"),
+	[]byte("ités"),
+	[]byte("issement"),
+	[]byte("ement"),
+	[]byte("amment"),
+	[]byte("emment"),
+	[]byte("ment"),
+	[]byte("aux"),
+	[]byte("eux"),
+	[]byte("ité"),
+}
+
+var step2aStepSuffixes = [...][]byte{
+	[]byte("ira"),
+	[]byte("ie"),
+	[]byte("isse"),
+	[]byte("issante"),
+	[]byte("irai"),
+	[]byte("i"),
+	[]byte("ir"),
+	[]byte("iras"),
+	[]byte("ies"),
+	[]byte("îmes"),
+	[]byte("isses"),
+	[]byte("issantes"),
+	[]byte("îtes"),
+	[]byte("irais"),
+	[]byte("issais"),
+	[]byte("is"),
+	[]byte("irions"),
+	[]byte("issions"),
+	[]byte("irons"),
+	[]byte("issons"),
+	[]byte("issants"),
+	[]byte("irait"),
+	[]byte("issait"),
+	[]byte("it"),
+	[]byte("issant"),
+	[]byte("iraIent"),
+	[]byte("issaIent"),
+	[]byte("irent"),
+	[]byte("issent"),
+	[]byte("iront"),
+	[]byte("ît"),
+	[]byte("iriez"),
+	[]byte("issiez"),
+	[]byte("irez"),
+	[]byte("issez"),
+}
+
+var step2bStepNested2Suffixes = [...][]byte{
+	[]byte("e"),
+}
+
+var step2bStepSuffixes = [...][]byte{
+	[]byte("era"),
+	[]byte("a"),
+	[]byte("asse"),
+	[]byte("ante"),
+	[]byte("ée"),
+	[]byte("erai"),
+	[]byte("ai"),
+	[]byte("er"),
+	[]byte("eras"),
+	[]byte("as"),
+	[]byte("âmes"),
+	[]byte("asses"),
+	[]byte("antes"),
+	[]byte("âtes"),
+	[]byte("ées"),
+	[]byte("erais"),
+	[]byte("ais"),
+	[]byte("erions"),
+	[]byte("assions"),
+	[]byte("ions"),
+	[]byte("erons"),
+	[]byte("ants"),
+	[]byte("és"),
+	[]byte("erait"),
+	[]byte("ait"),
+	[]byte("ant"),
+	[]byte("eraIent"),
+	[]byte("aIent"),
+	[]byte("èrent"),
+	[]byte("assent"),
+	[]byte("eront"),
+	[]byte("ât"),
+	[]byte("eriez"),
+	[]byte("assiez"),
+	[]byte("iez"),
+	[]byte("erez"),
+	[]byte("ez"),
+	[]byte("é"),
+}
+
+var step4StepSuffixes = [...][]byte{
+	[]byte("Ière"),
+	[]byte("ière"),
+	[]byte("e"),
+	[]byte("ion"),
+	[]byte("Ier"),
+	[]byte("ier"),
+	[]byte("ë"),
+}
+
+var step5StepSuffixes = [...][]byte{
+	[]byte("ell"),
+	[]byte("eill"),
+	[]byte("enn"),
+	[]byte("onn"),
+	[]byte("ett"),
+}
+
+func step1StepNested1Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					r := step1StepNested1Callbacks[0](s, step1StepNested1Suffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested5Nested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested5Nested0Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested5Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'U':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'q':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[0]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'b':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[1]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xa8:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'I':
+									if !bytes.HasSuffix(rv(s), step1StepNested5Suffixes[2]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "i"...)
+									return r, true
+								case 'i':
+									if !bytes.HasSuffix(rv(s), step1StepNested5Suffixes[3]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "i"...)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							r := step1Eus(s, step1StepNested5Suffixes[4])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[5]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step1StepNested5Nested0Do(r)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested6Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					r := step1StepNested6Callbacks[0](s, step1StepNested6Suffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									r := step1StepNested6Callbacks[1](s, step1StepNested6Suffixes[1])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested7Nested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					r := step1StepNested7Nested0Callbacks[0](s, step1StepNested7Nested0Suffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested7Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested7Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step1StepNested7Nested0Do(r)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'U':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'q':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[0]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 't':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[1]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested1Do(r)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[2]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'e':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[3]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "ent"...)
+									return r, true
+								}
+							}
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'g':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[4]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "log"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[5]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[6]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'u':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									r := step1Eus(s, step1StepSuffixes[7])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[8]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[9]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested7Do(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'f':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepSuffixes[10]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step1StepNested7Do(r)
+					return r, true
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'u':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[11]) {
+												return s, false
+											}
+											r := s[:len(s)-4]
+											return r, true
+										}
+									}
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[12]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested1Do(r)
+											return r, true
+										case 'u':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[13]) {
+												return s, false
+											}
+											r := s[:len(s)-4]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[14]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested1Do(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'U':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'q':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[15]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 't':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[16]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															r, _ = step1StepNested1Do(r)
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[17]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										case 'e':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[18]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "ent"...)
+											return r, true
+										}
+									}
+								}
+							}
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'g':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'o':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'l':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[19]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "log"...)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'l':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[20]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[21]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'u':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											r := step1Eus(s, step1StepSuffixes[22])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[23]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 'v':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[24]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested7Do(r)
+									return r, true
+								}
+							}
+						}
+					}
+				case 'f':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[25]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested7Do(r)
+							return r, true
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'u':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[26]) {
+														return s, false
+													}
+													r := s[:len(s)-5]
+													return r, true
+												}
+											}
+										case 't':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[27]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested1Do(r)
+													return r, true
+												case 'u':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[28]) {
+														return s, false
+													}
+													r := s[:len(s)-5]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'u':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 't':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[29]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested1Do(r)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'm':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 's':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 's':
+																	if len(s) > 8 {
+																		switch s[len(s)-9] {
+																		case 'i':
+																			if !bytes.HasSuffix(r1(s), step1StepSuffixes[30]) {
+																				return s, false
+																			}
+																			r := step1Issement(s, step1StepSuffixes[30])
+																			if r == nil {
+																				return s, false
+																			}
+																			return r, true
+																		}
+																	}
+																}
+															}
+														}
+													}
+													if !bytes.HasSuffix(rv(s), step1StepSuffixes[31]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested5Do(r)
+													return r, true
+												}
+											}
+											r := step1Ment(s, step1StepSuffixes[32])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[33]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested6Do(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'm':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 's':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 's':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'i':
+																	if !bytes.HasSuffix(r1(s), step1StepSuffixes[34]) {
+																		return s, false
+																	}
+																	r := step1Issement(s, step1StepSuffixes[34])
+																	if r == nil {
+																		return s, false
+																	}
+																	return r, true
+																}
+															}
+														}
+													}
+												}
+											}
+											if !bytes.HasSuffix(rv(s), step1StepSuffixes[35]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested5Do(r)
+											return r, true
+										case 'm':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(rv(s), step1StepSuffixes[36]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "ant"...)
+													return r, true
+												case 'e':
+													if !bytes.HasSuffix(rv(s), step1StepSuffixes[37]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "ent"...)
+													return r, true
+												}
+											}
+										}
+									}
+									r := step1Ment(s, step1StepSuffixes[38])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'x':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									r := s[:len(s)-1]
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r1(s), step1StepSuffixes[39]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "al"...)
+							return r, true
+						case 'e':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[40]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[41]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested6Do(r)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2aStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2aStepSuffixes[0]) {
+								r := step2aCB(s, step2aStepSuffixes[0])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if bytes.HasSuffix(rv(s), step2aStepSuffixes[1]) {
+						r := step2aCB(s, step2aStepSuffixes[1])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2aStepSuffixes[2]) {
+										r := step2aCB(s, step2aStepSuffixes[2])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 's':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'i':
+															if bytes.HasSuffix(rv(s), step2aStepSuffixes[3]) {
+																r := step2aCB(s, step2aStepSuffixes[3])
+																if r == nil {
+																	return s, false
+																}
+																return r, true
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2aStepSuffixes[4]) {
+										r := step2aCB(s, step2aStepSuffixes[4])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+			if bytes.HasSuffix(rv(s), step2aStepSuffixes[5]) {
+				r := step2aCB(s, step2aStepSuffixes[5])
+				if r == nil {
+					return s, false
+				}
+				return r, true
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if bytes.HasSuffix(rv(s), step2aStepSuffixes[6]) {
+						r := step2aCB(s, step2aStepSuffixes[6])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2aStepSuffixes[7]) {
+										r := step2aCB(s, step2aStepSuffixes[7])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2aStepSuffixes[8]) {
+								r := step2aCB(s, step2aStepSuffixes[8])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xae:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[9]) {
+												r := step2aCB(s, step2aStepSuffixes[9])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[10]) {
+												r := step2aCB(s, step2aStepSuffixes[10])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 's':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 's':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'i':
+																	if bytes.HasSuffix(rv(s), step2aStepSuffixes[11]) {
+																		r := step2aCB(s, step2aStepSuffixes[11])
+																		if r == nil {
+																			return s, false
+																		}
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								case 0xae:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[12]) {
+												r := step2aCB(s, step2aStepSuffixes[12])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[13]) {
+												r := step2aCB(s, step2aStepSuffixes[13])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2aStepSuffixes[14]) {
+														r := step2aCB(s, step2aStepSuffixes[14])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2aStepSuffixes[15]) {
+						r := step2aCB(s, step2aStepSuffixes[15])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2aStepSuffixes[16]) {
+														r := step2aCB(s, step2aStepSuffixes[16])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 's':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'i':
+															if bytes.HasSuffix(rv(s), step2aStepSuffixes[17]) {
+																r := step2aCB(s, step2aStepSuffixes[17])
+																if r == nil {
+																	return s, false
+																}
+																return r, true
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[18]) {
+												r := step2aCB(s, step2aStepSuffixes[18])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2aStepSuffixes[19]) {
+														r := step2aCB(s, step2aStepSuffixes[19])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 's':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'i':
+															if bytes.HasSuffix(rv(s), step2aStepSuffixes[20]) {
+																r := step2aCB(s, step2aStepSuffixes[20])
+																if r == nil {
+																	return s, false
+																}
+																return r, true
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[21]) {
+												r := step2aCB(s, step2aStepSuffixes[21])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2aStepSuffixes[22]) {
+														r := step2aCB(s, step2aStepSuffixes[22])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2aStepSuffixes[23]) {
+						r := step2aCB(s, step2aStepSuffixes[23])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2aStepSuffixes[24]) {
+														r := step2aCB(s, step2aStepSuffixes[24])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'I':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'r':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'i':
+															if bytes.HasSuffix(rv(s), step2aStepSuffixes[25]) {
+																r := step2aCB(s, step2aStepSuffixes[25])
+																if r == nil {
+																	return s, false
+																}
+																return r, true
+															}
+														}
+													}
+												case 's':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 's':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'i':
+																	if bytes.HasSuffix(rv(s), step2aStepSuffixes[26]) {
+																		r := step2aCB(s, step2aStepSuffixes[26])
+																		if r == nil {
+																			return s, false
+																		}
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[27]) {
+												r := step2aCB(s, step2aStepSuffixes[27])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2aStepSuffixes[28]) {
+														r := step2aCB(s, step2aStepSuffixes[28])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[29]) {
+												r := step2aCB(s, step2aStepSuffixes[29])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 0xae:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if bytes.HasSuffix(rv(s), step2aStepSuffixes[30]) {
+								r := step2aCB(s, step2aStepSuffixes[30])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'z':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[31]) {
+												r := step2aCB(s, step2aStepSuffixes[31])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2aStepSuffixes[32]) {
+														r := step2aCB(s, step2aStepSuffixes[32])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2aStepSuffixes[33]) {
+										r := step2aCB(s, step2aStepSuffixes[33])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[34]) {
+												r := step2aCB(s, step2aStepSuffixes[34])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2bStepNested2Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if bytes.HasSuffix(rv(s), step2bStepNested2Suffixes[0]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		}
+	}
+	return s, false
+}
+
+func step2bStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[0]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+			if bytes.HasSuffix(rv(s), step2bStepSuffixes[1]) {
+				r := s[:len(s)-1]
+				r, _ = step2bStepNested2Do(r)
+				return r, true
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[2]) {
+										r := s[:len(s)-4]
+										r, _ = step2bStepNested2Do(r)
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[3]) {
+										r := s[:len(s)-4]
+										r, _ = step2bStepNested2Do(r)
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[4]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[5]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[6]) {
+						r := s[:len(s)-2]
+						r, _ = step2bStepNested2Do(r)
+						return r, true
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[7]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[8]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[9]) {
+						r := s[:len(s)-2]
+						r, _ = step2bStepNested2Do(r)
+						return r, true
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xa2:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[10]) {
+												r := s[:len(s)-5]
+												r, _ = step2bStepNested2Do(r)
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[11]) {
+												r := s[:len(s)-5]
+												r, _ = step2bStepNested2Do(r)
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[12]) {
+												r := s[:len(s)-5]
+												r, _ = step2bStepNested2Do(r)
+												return r, true
+											}
+										}
+									}
+								case 0xa2:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[13]) {
+												r := s[:len(s)-5]
+												r, _ = step2bStepNested2Do(r)
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[14]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[15]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[16]) {
+								r := s[:len(s)-3]
+								r, _ = step2bStepNested2Do(r)
+								return r, true
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[17]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 's':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if bytes.HasSuffix(rv(s), step2bStepSuffixes[18]) {
+																r := s[:len(s)-7]
+																r, _ = step2bStepNested2Do(r)
+																return r, true
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[19]) {
+										if !bytes.HasSuffix(r2(s), step2bStepSuffixes[19]) {
+											return s, false
+										}
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[20]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[21]) {
+										r := s[:len(s)-4]
+										r, _ = step2bStepNested2Do(r)
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[22]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[23]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[24]) {
+								r := s[:len(s)-3]
+								r, _ = step2bStepNested2Do(r)
+								return r, true
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[25]) {
+								r := s[:len(s)-3]
+								r, _ = step2bStepNested2Do(r)
+								return r, true
+							}
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'I':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'r':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'e':
+															if bytes.HasSuffix(rv(s), step2bStepSuffixes[26]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												}
+											}
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[27]) {
+												r := s[:len(s)-5]
+												r, _ = step2bStepNested2Do(r)
+												return r, true
+											}
+										}
+									}
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xa8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[28]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[29]) {
+														r := s[:len(s)-6]
+														r, _ = step2bStepNested2Do(r)
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[30]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 0xa2:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[31]) {
+								r := s[:len(s)-3]
+								r, _ = step2bStepNested2Do(r)
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'z':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[32]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[33]) {
+														r := s[:len(s)-6]
+														r, _ = step2bStepNested2Do(r)
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[34]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[35]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[36]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[37]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step4StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xa8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'I':
+											if bytes.HasSuffix(rv(s), step4StepSuffixes[0]) {
+												r := append(s[:len(s)-5], "i"...)
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step4StepSuffixes[1]) {
+												r := append(s[:len(s)-5], "i"...)
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+			if bytes.HasSuffix(rv(s), step4StepSuffixes[2]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if bytes.HasSuffix(rv(s), step4StepSuffixes[3]) {
+								if !bytes.HasSuffix(r2(s), step4StepSuffixes[3]) {
+									return s, false
+								}
+								r := step4Ion(s, step4StepSuffixes[3])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'I':
+							if bytes.HasSuffix(rv(s), step4StepSuffixes[4]) {
+								r := append(s[:len(s)-3], "i"...)
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step4StepSuffixes[5]) {
+								r := append(s[:len(s)-3], "i"...)
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 0xab:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if bytes.HasSuffix(rv(s), step4StepSuffixes[6]) {
+						r := step4Gu(s, step4StepSuffixes[6])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step5StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							r := undouble(s, step5StepSuffixes[0])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									r := undouble(s, step5StepSuffixes[1])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							r := undouble(s, step5StepSuffixes[2])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 'o':
+							r := undouble(s, step5StepSuffixes[3])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							r := undouble(s, step5StepSuffixes[4])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
@@ -0,0 +1,180 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2german
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/german/stemmer.html
+
+const vowels = "aeiouyäöü"
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune(vowels, r)
+}
+
+var (
+	r1 = ScandinavianR1(vowels)
+	r2 = R2(vowels)
+)
+
+func normalize(s []byte) []byte {
+	// ß and ss have the same length in UTF-8
+	for i := bytes.Index(s, []byte("ß")); i >= 0; i = bytes.Index(s, []byte("ß")) {
+		s[i], s[i+1] = 's', 's'
+	}
+	for i := 0; i < len(s); {
+		r, l := utf8.DecodeRune(s[i:])
+		j := i + l
+		if isVowel(r) && j < len(s) && (s[j] == 'u' || s[j] == 'y') {
+			nr, nl := utf8.DecodeRune(s[j+1:])
+			if isVowel(nr) {
+				s[j] = s[j] - 'a' + 'A'
+				i = j + 1 + nl
+				continue
+			}
+		}
+		i = j
+	}
+	return s
+}
+
+func step1Niss(s []byte, suffix []byte) []byte {
+	s = Delete(s, suffix)
+	if bytes.HasSuffix(s, []byte("niss")) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+func step1S(s []byte, suffix []byte) []byte {
+	const validSEnding = "bdfghklmnrt"
+	if len(s) > len(suffix) && strings.IndexByte(validSEnding, s[len(s)-len(suffix)-1]) >= 0 {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "em ern er", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "e en es", Callback: step1Niss, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "s", Callback: step1S, ActionRegion: r1, Region: "R1"},
+})
+
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
+}
+
+func step2St(s []byte, suffix []byte) []byte {
+	const validStEnding = "bdfghklmnt"
+	m := s[:len(s)-len(suffix)]
+	if len(m) > 0 && strings.IndexByte(validStEnding, m[len(m)-1]) >= 0 &&
+		utf8.RuneCount(m[:len(m)-1]) >= 3 {
+		return m
+	}
+	return nil
+}
+
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "en er est", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "st", Callback: step2St, ActionRegion: r1, Region: "R1"},
+})
+
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
+}
+
+func step3NotE(s []byte, suffix []byte) []byte {
+	if !bytes.HasSuffix(s[:len(s)-len(suffix)], []byte("e")) {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+var step3Step = MustNewStep([]Suffix{
+	{Suffixes: "end ung", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "ig", Callback: step3NotE, ActionRegion: r2, Region: "R2"}}},
+	{Suffixes: "ig ik isch", Callback: step3NotE, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "lich heit", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "er en", Callback: Delete, ActionRegion: r1, Region: "R1"}}},
+	{Suffixes: "keit", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "lich ig", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+})
+
+func step3(s []byte, t *Tracer) []byte {
+	return t.Hook(step3Step).Apply(s)
+}
+
+func postlude(s []byte) []byte {
+	return MapRunes(s, postludeRune)
+}
+
+func postludeRune(r rune) rune {
+	switch r {
+	case 'U', 'ü':
+		return 'u'
+	case 'Y':
+		return 'y'
+	case 'ä':
+		return 'a'
+	case 'ö':
+		return 'o'
+	default:
+		return r
+	}
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = normalize(s)
+	t.Record("normalize", s)
+	s = step1(s, t)
+	t.Record("step1", s)
+	s = step2(s, t)
+	t.Record("step2", s)
+	s = step3(s, t)
+	t.Record("step3", s)
+	s = postlude(s)
+	t.Record("postlude", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
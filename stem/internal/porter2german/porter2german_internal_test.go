@@ -0,0 +1,66 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2german
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestNormalize(t *testing.T) {
+	pairs := []string{"fuß", "fuss", "bauen", "baUen", "bayer", "baYer", "neue", "neUe"}
+	test(t, normalize, pairs)
+}
+
+func TestR1(t *testing.T) {
+	pairs := []string{"aufeinander", "einander", "abend", "nd", "ab", "", "ärger", "er"}
+	test(t, r1, pairs)
+}
+
+func TestR2(t *testing.T) {
+	pairs := []string{"aufeinander", "ander", "abend", "d"}
+	test(t, r2, pairs)
+}
+
+func TestStep1(t *testing.T) {
+	pairs := []string{"kenntnisse", "kenntnis", "aufenthaltes", "aufenthalt", "katers", "kater", "häuses", "häus"}
+	test(t, untraced(step1), pairs)
+}
+
+func TestStep2(t *testing.T) {
+	pairs := []string{"auferstehst", "aufersteh", "erbst", "erbst", "kategorisch", "kategorisch"}
+	test(t, untraced(step2), pairs)
+}
+
+func TestStep3(t *testing.T) {
+	pairs := []string{"auferstehung", "aufersteh", "heiterkeit", "heiter", "freundlichkeit", "freundlich"}
+	test(t, untraced(step3), pairs)
+}
@@ -0,0 +1,417 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2german
+
+import (
+	"bytes"
+)
+
+func init() {
+	step1Step.SetDo(step1StepDo)
+	step2Step.SetDo(step2StepDo)
+	step3Step.SetDo(step3StepDo)
+}
+
+var step1StepSuffixes = [...][]byte{
+	[]byte("e"),
+	[]byte("em"),
+	[]byte("en"),
+	[]byte("ern"),
+	[]byte("er"),
+	[]byte("es"),
+	[]byte("s"),
+}
+
+var step2StepSuffixes = [...][]byte{
+	[]byte("en"),
+	[]byte("er"),
+	[]byte("est"),
+	[]byte("st"),
+}
+
+var step3StepNested0Suffixes = [...][]byte{
+	[]byte("ig"),
+}
+
+var step3StepNested2Suffixes = [...][]byte{
+	[]byte("en"),
+	[]byte("er"),
+}
+
+var step3StepNested3Suffixes = [...][]byte{
+	[]byte("ig"),
+	[]byte("lich"),
+}
+
+var step3StepSuffixes = [...][]byte{
+	[]byte("end"),
+	[]byte("ig"),
+	[]byte("ung"),
+	[]byte("lich"),
+	[]byte("isch"),
+	[]byte("ik"),
+	[]byte("heit"),
+	[]byte("keit"),
+}
+
+func step1StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if !bytes.HasSuffix(r1(s), step1StepSuffixes[0]) {
+				return s, false
+			}
+			r := step1Niss(s, step1StepSuffixes[0])
+			if r == nil {
+				return s, false
+			}
+			return r, true
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if !bytes.HasSuffix(r1(s), step1StepSuffixes[1]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if !bytes.HasSuffix(r1(s), step1StepSuffixes[2]) {
+						return s, false
+					}
+					r := step1Niss(s, step1StepSuffixes[2])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if !bytes.HasSuffix(r1(s), step1StepSuffixes[3]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if !bytes.HasSuffix(r1(s), step1StepSuffixes[4]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if !bytes.HasSuffix(r1(s), step1StepSuffixes[5]) {
+						return s, false
+					}
+					r := step1Niss(s, step1StepSuffixes[5])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+			if !bytes.HasSuffix(r1(s), step1StepSuffixes[6]) {
+				return s, false
+			}
+			r := step1S(s, step1StepSuffixes[6])
+			if r == nil {
+				return s, false
+			}
+			return r, true
+		}
+	}
+	return s, false
+}
+
+func step2StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if !bytes.HasSuffix(r1(s), step2StepSuffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if !bytes.HasSuffix(r1(s), step2StepSuffixes[1]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if !bytes.HasSuffix(r1(s), step2StepSuffixes[2]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+					if !bytes.HasSuffix(r1(s), step2StepSuffixes[3]) {
+						return s, false
+					}
+					r := step2St(s, step2StepSuffixes[3])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepNested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step3StepNested0Suffixes[0]) {
+						return s, false
+					}
+					r := step3NotE(s, step3StepNested0Suffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepNested2Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if !bytes.HasSuffix(r1(s), step3StepNested2Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if !bytes.HasSuffix(r1(s), step3StepNested2Suffixes[1]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepNested3Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step3StepNested3Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'h':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if !bytes.HasSuffix(r2(s), step3StepNested3Suffixes[1]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if !bytes.HasSuffix(r2(s), step3StepSuffixes[0]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step3StepNested0Do(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step3StepSuffixes[1]) {
+						return s, false
+					}
+					r := step3NotE(s, step3StepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'u':
+							if !bytes.HasSuffix(r2(s), step3StepSuffixes[2]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step3StepNested0Do(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'h':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if !bytes.HasSuffix(r2(s), step3StepSuffixes[3]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step3StepNested2Do(r)
+									return r, true
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step3StepSuffixes[4]) {
+										return s, false
+									}
+									r := step3NotE(s, step3StepSuffixes[4])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'k':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step3StepSuffixes[5]) {
+						return s, false
+					}
+					r := step3NotE(s, step3StepSuffixes[5])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'h':
+									if !bytes.HasSuffix(r2(s), step3StepSuffixes[6]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step3StepNested2Do(r)
+									return r, true
+								case 'k':
+									if !bytes.HasSuffix(r2(s), step3StepSuffixes[7]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step3StepNested3Do(r)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
@@ -0,0 +1,222 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2hungarian
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/hungarian/stemmer.html
+
+const vowels = "aeiouáéíóöőúüű"
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune(vowels, r)
+}
+
+var digraphs = []string{"dzs", "cs", "gy", "ly", "ny", "sz", "ty", "zs"}
+
+// r1 is the region after the first consonant (counting digraphs as one
+// letter) if the word begins with a vowel, otherwise the region after the
+// first vowel.
+func r1(s []byte) []byte {
+	r, l := utf8.DecodeRune(s)
+	if l == 0 {
+		return s
+	}
+	if !isVowel(r) {
+		i := bytes.IndexFunc(s, isVowel)
+		if i < 0 {
+			return s[len(s):]
+		}
+		_, l = utf8.DecodeRune(s[i:])
+		return s[i+l:]
+	}
+	i := bytes.IndexFunc(s, func(r rune) bool { return !isVowel(r) })
+	if i < 0 {
+		return s[len(s):]
+	}
+	for _, d := range digraphs {
+		if bytes.HasPrefix(s[i:], []byte(d)) {
+			return s[i+len(d):]
+		}
+	}
+	_, l = utf8.DecodeRune(s[i:])
+	return s[i+l:]
+}
+
+const doubles = "bb cc ccs dd ff gg ggy jj kk ll lly mm nn nny pp rr ss ssz tt tty vv zz zzs"
+
+var doubleList = bytes.Fields([]byte(doubles))
+
+// deleteDouble deletes the suffix only if it follows a double consonant.
+func deleteDouble(s []byte, suffix []byte) []byte {
+	p := Delete(s, suffix)
+	for _, d := range doubleList {
+		if bytes.HasSuffix(p, d) {
+			return p
+		}
+	}
+	return nil
+}
+
+// undouble removes the first letter of a double consonant,
+// ccs becomes cs.
+func undouble(s []byte, suffix []byte) []byte {
+	return append(s[:len(s)-2], s[len(s)-1])
+}
+
+var undoubleStep = []Suffix{{Suffixes: doubles, Callback: undouble}}
+
+var instrumStep = MustNewStep([]Suffix{
+	{Suffixes: "al el", Callback: deleteDouble, ActionRegion: r1, Region: "R1", SuffixCallbacks: undoubleStep},
+})
+
+func instrum(s []byte, t *Tracer) []byte {
+	return t.Hook(instrumStep).Apply(s)
+}
+
+var vEndingStep = []Suffix{
+	{Suffixes: "á", Callback: Replace("a"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "é", Callback: Replace("e"), ActionRegion: r1, Region: "R1"},
+}
+
+var caseStep = MustNewStep([]Suffix{
+	{Suffixes: "ban ben ba be ra re nak nek val vel tól től ról ről ból ből hoz hez höz nál nél ig at et ot öt ért képp képpen kor ul ül vá vé onként enként anként ként en on an ön n t", Callback: Delete, ActionRegion: r1, Region: "R1", SuffixCallbacks: vEndingStep},
+})
+
+func caseEnding(s []byte, t *Tracer) []byte {
+	return t.Hook(caseStep).Apply(s)
+}
+
+var caseSpecialStep = MustNewStep([]Suffix{
+	{Suffixes: "én", Callback: Replace("e"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "án ánként", Callback: Replace("a"), ActionRegion: r1, Region: "R1"},
+})
+
+func caseSpecial(s []byte, t *Tracer) []byte {
+	return t.Hook(caseSpecialStep).Apply(s)
+}
+
+var caseOtherStep = MustNewStep([]Suffix{
+	{Suffixes: "astul estül stul stül", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ástul", Callback: Replace("a"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "éstül", Callback: Replace("e"), ActionRegion: r1, Region: "R1"},
+})
+
+func caseOther(s []byte, t *Tracer) []byte {
+	return t.Hook(caseOtherStep).Apply(s)
+}
+
+var factiveStep = MustNewStep([]Suffix{
+	{Suffixes: "á é", Callback: deleteDouble, ActionRegion: r1, Region: "R1", SuffixCallbacks: undoubleStep},
+})
+
+func factive(s []byte, t *Tracer) []byte {
+	return t.Hook(factiveStep).Apply(s)
+}
+
+var pluralStep = MustNewStep([]Suffix{
+	{Suffixes: "ák", Callback: Replace("a"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ék", Callback: Replace("e"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ök ak ok ek k", Callback: Delete, ActionRegion: r1, Region: "R1"},
+})
+
+func plural(s []byte, t *Tracer) []byte {
+	return t.Hook(pluralStep).Apply(s)
+}
+
+var ownedStep = MustNewStep([]Suffix{
+	{Suffixes: "oké öké aké eké ké éi é", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "éké ééi éé", Callback: Replace("e"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "áké áéi", Callback: Replace("a"), ActionRegion: r1, Region: "R1"},
+})
+
+func owned(s []byte, t *Tracer) []byte {
+	return t.Hook(ownedStep).Apply(s)
+}
+
+var singOwnerStep = MustNewStep([]Suffix{
+	{Suffixes: "ünk unk nk juk jük uk ük em om am m od ed ad öd d ja je a e o", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "ánk ájuk ám ád á", Callback: Replace("a"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "énk éjük ém éd é", Callback: Replace("e"), ActionRegion: r1, Region: "R1"},
+})
+
+func singOwner(s []byte, t *Tracer) []byte {
+	return t.Hook(singOwnerStep).Apply(s)
+}
+
+var plurOwnerStep = MustNewStep([]Suffix{
+	{Suffixes: "jaim jeim aim eim im jaid jeid aid eid id jai jei ai ei i jaink jeink eink aink ink jaitok jeitek aitok eitek itek jeik jaik aik eik ik", Callback: Delete, ActionRegion: r1, Region: "R1"},
+	{Suffixes: "áim áid ái áink áitok áik", Callback: Replace("a"), ActionRegion: r1, Region: "R1"},
+	{Suffixes: "éim éid éi éink éitek éik", Callback: Replace("e"), ActionRegion: r1, Region: "R1"},
+})
+
+func plurOwner(s []byte, t *Tracer) []byte {
+	return t.Hook(plurOwnerStep).Apply(s)
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = instrum(s, t)
+	t.Record("instrum", s)
+	s = caseEnding(s, t)
+	t.Record("caseEnding", s)
+	s = caseSpecial(s, t)
+	t.Record("caseSpecial", s)
+	s = caseOther(s, t)
+	t.Record("caseOther", s)
+	s = factive(s, t)
+	t.Record("factive", s)
+	s = owned(s, t)
+	t.Record("owned", s)
+	s = singOwner(s, t)
+	t.Record("singOwner", s)
+	s = plurOwner(s, t)
+	t.Record("plurOwner", s)
+	s = plural(s, t)
+	t.Record("plural", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
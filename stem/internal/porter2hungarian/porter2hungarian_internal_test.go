@@ -0,0 +1,66 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2hungarian
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestR1(t *testing.T) {
+	pairs := []string{"almát", "mát", "ablak", "lak", "agyon", "on", "edzés", "zés", "ecset", "et", "kert", "rt", "tv", ""}
+	test(t, r1, pairs)
+}
+
+func TestInstrum(t *testing.T) {
+	pairs := []string{"kézzel", "kéz", "tavasszal", "tavasz", "tollal", "tol", "autóval", "autóval", "ággyal", "ágy"}
+	test(t, untraced(instrum), pairs)
+}
+
+func TestCaseEnding(t *testing.T) {
+	pairs := []string{"házban", "ház", "almát", "alma", "szobában", "szoba", "papírra", "papír"}
+	test(t, untraced(caseEnding), pairs)
+}
+
+func TestCaseSpecial(t *testing.T) {
+	pairs := []string{"utcán", "utca", "szépen", "szépen", "zenén", "zene"}
+	test(t, untraced(caseSpecial), pairs)
+}
+
+func TestFactive(t *testing.T) {
+	pairs := []string{"tavasszá", "tavasz", "várossá", "város", "kerté", "kerté"}
+	test(t, untraced(factive), pairs)
+}
+
+func TestPlural(t *testing.T) {
+	pairs := []string{"kutyák", "kutya", "nyelvek", "nyelv", "almafák", "almafa"}
+	test(t, untraced(plural), pairs)
+}
@@ -0,0 +1,2265 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2hungarian
+
+import (
+	"bytes"
+)
+
+func init() {
+	caseOtherStep.SetDo(caseOtherStepDo)
+	caseSpecialStep.SetDo(caseSpecialStepDo)
+	caseStep.SetDo(caseStepDo)
+	factiveStep.SetDo(factiveStepDo)
+	instrumStep.SetDo(instrumStepDo)
+	ownedStep.SetDo(ownedStepDo)
+	plurOwnerStep.SetDo(plurOwnerStepDo)
+	pluralStep.SetDo(pluralStepDo)
+	singOwnerStep.SetDo(singOwnerStepDo)
+}
+
+var caseOtherStepSuffixes = [...][]byte{
+	[]byte("astul"),
+	[]byte("ástul"),
+	[]byte("stul"),
+	[]byte("estül"),
+	[]byte("éstül"),
+	[]byte("stül"),
+}
+
+var caseSpecialStepSuffixes = [...][]byte{
+	[]byte("án"),
+	[]byte("én"),
+	[]byte("ánként"),
+}
+
+var caseStepSuffixes = [...][]byte{
+	[]byte("ba"),
+	[]byte("ra"),
+	[]byte("be"),
+	[]byte("re"),
+	[]byte("ig"),
+	[]byte("nak"),
+	[]byte("nek"),
+	[]byte("val"),
+	[]byte("vel"),
+	[]byte("ul"),
+	[]byte("ből"),
+	[]byte("ről"),
+	[]byte("től"),
+	[]byte("nál"),
+	[]byte("nél"),
+	[]byte("ból"),
+	[]byte("ról"),
+	[]byte("tól"),
+	[]byte("ül"),
+	[]byte("ban"),
+	[]byte("an"),
+	[]byte("ben"),
+	[]byte("képpen"),
+	[]byte("en"),
+	[]byte("on"),
+	[]byte("ön"),
+	[]byte("n"),
+	[]byte("képp"),
+	[]byte("kor"),
+	[]byte("at"),
+	[]byte("et"),
+	[]byte("anként"),
+	[]byte("enként"),
+	[]byte("onként"),
+	[]byte("ként"),
+	[]byte("ot"),
+	[]byte("ért"),
+	[]byte("öt"),
+	[]byte("t"),
+	[]byte("hez"),
+	[]byte("hoz"),
+	[]byte("höz"),
+	[]byte("vá"),
+	[]byte("vé"),
+}
+
+var factiveStepSuffixes = [...][]byte{
+	[]byte("á"),
+	[]byte("é"),
+}
+
+var instrumStepSuffixes = [...][]byte{
+	[]byte("al"),
+	[]byte("el"),
+}
+
+var ownedStepSuffixes = [...][]byte{
+	[]byte("áéi"),
+	[]byte("ééi"),
+	[]byte("éi"),
+	[]byte("aké"),
+	[]byte("eké"),
+	[]byte("oké"),
+	[]byte("áké"),
+	[]byte("éké"),
+	[]byte("öké"),
+	[]byte("ké"),
+	[]byte("éé"),
+	[]byte("é"),
+}
+
+var plurOwnerStepSuffixes = [...][]byte{
+	[]byte("jaid"),
+	[]byte("aid"),
+	[]byte("jeid"),
+	[]byte("eid"),
+	[]byte("áid"),
+	[]byte("éid"),
+	[]byte("id"),
+	[]byte("jai"),
+	[]byte("ai"),
+	[]byte("jei"),
+	[]byte("ei"),
+	[]byte("ái"),
+	[]byte("éi"),
+	[]byte("i"),
+	[]byte("jeitek"),
+	[]byte("eitek"),
+	[]byte("éitek"),
+	[]byte("itek"),
+	[]byte("jaik"),
+	[]byte("aik"),
+	[]byte("jeik"),
+	[]byte("eik"),
+	[]byte("áik"),
+	[]byte("éik"),
+	[]byte("ik"),
+	[]byte("jaink"),
+	[]byte("aink"),
+	[]byte("jeink"),
+	[]byte("eink"),
+	[]byte("áink"),
+	[]byte("éink"),
+	[]byte("ink"),
+	[]byte("jaitok"),
+	[]byte("aitok"),
+	[]byte("áitok"),
+	[]byte("jaim"),
+	[]byte("aim"),
+	[]byte("jeim"),
+	[]byte("eim"),
+	[]byte("áim"),
+	[]byte("éim"),
+	[]byte("im"),
+}
+
+var pluralStepSuffixes = [...][]byte{
+	[]byte("ak"),
+	[]byte("ek"),
+	[]byte("ok"),
+	[]byte("ák"),
+	[]byte("ék"),
+	[]byte("ök"),
+	[]byte("k"),
+}
+
+var singOwnerStepSuffixes = [...][]byte{
+	[]byte("ja"),
+	[]byte("a"),
+	[]byte("ad"),
+	[]byte("ed"),
+	[]byte("od"),
+	[]byte("ád"),
+	[]byte("éd"),
+	[]byte("öd"),
+	[]byte("d"),
+	[]byte("je"),
+	[]byte("e"),
+	[]byte("unk"),
+	[]byte("ánk"),
+	[]byte("énk"),
+	[]byte("ünk"),
+	[]byte("nk"),
+	[]byte("ájuk"),
+	[]byte("juk"),
+	[]byte("uk"),
+	[]byte("éjük"),
+	[]byte("jük"),
+	[]byte("ük"),
+	[]byte("am"),
+	[]byte("em"),
+	[]byte("om"),
+	[]byte("ám"),
+	[]byte("ém"),
+	[]byte("m"),
+	[]byte("o"),
+	[]byte("á"),
+	[]byte("é"),
+}
+
+var undoubleStepSuffixes = [...][]byte{
+	[]byte("bb"),
+	[]byte("cc"),
+	[]byte("dd"),
+	[]byte("ff"),
+	[]byte("gg"),
+	[]byte("jj"),
+	[]byte("kk"),
+	[]byte("ll"),
+	[]byte("mm"),
+	[]byte("nn"),
+	[]byte("pp"),
+	[]byte("rr"),
+	[]byte("ccs"),
+	[]byte("ss"),
+	[]byte("zzs"),
+	[]byte("tt"),
+	[]byte("vv"),
+	[]byte("ggy"),
+	[]byte("lly"),
+	[]byte("nny"),
+	[]byte("tty"),
+	[]byte("ssz"),
+	[]byte("zz"),
+}
+
+var vEndingStepSuffixes = [...][]byte{
+	[]byte("á"),
+	[]byte("é"),
+}
+
+func caseOtherStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r1(s), caseOtherStepSuffixes[0]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										case 0xa1:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if !bytes.HasSuffix(r1(s), caseOtherStepSuffixes[1]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "a"...)
+													return r, true
+												}
+											}
+										}
+									}
+									if !bytes.HasSuffix(r1(s), caseOtherStepSuffixes[2]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 0xbc:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if !bytes.HasSuffix(r1(s), caseOtherStepSuffixes[3]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												case 0xa9:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if !bytes.HasSuffix(r1(s), caseOtherStepSuffixes[4]) {
+																return s, false
+															}
+															r := append(s[:len(s)-7], "e"...)
+															return r, true
+														}
+													}
+												}
+											}
+											if !bytes.HasSuffix(r1(s), caseOtherStepSuffixes[5]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func caseSpecialStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xa1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), caseSpecialStepSuffixes[0]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "a"...)
+							return r, true
+						}
+					}
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), caseSpecialStepSuffixes[1]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "e"...)
+							return r, true
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'k':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'n':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xa1:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xc3:
+																	if !bytes.HasSuffix(r1(s), caseSpecialStepSuffixes[2]) {
+																		return s, false
+																	}
+																	r := append(s[:len(s)-8], "a"...)
+																	return r, true
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func vEndingStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0xa1:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(r1(s), vEndingStepSuffixes[0]) {
+						return s, false
+					}
+					r := append(s[:len(s)-2], "a"...)
+					return r, true
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(r1(s), vEndingStepSuffixes[1]) {
+						return s, false
+					}
+					r := append(s[:len(s)-2], "e"...)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func caseStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'b':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				case 'r':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[1]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'b':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				case 'r':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[3]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				}
+			}
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[4]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				}
+			}
+		case 'k':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[5]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[6]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'v':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[7]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'v':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[8]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				case 'u':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[9]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				case 0x91:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[10]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								case 'r':
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[11]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								case 't':
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[12]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								}
+							}
+						}
+					}
+				case 0xa1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[13]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								}
+							}
+						}
+					}
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[14]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								}
+							}
+						}
+					}
+				case 0xb3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[15]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								case 'r':
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[16]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								case 't':
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[17]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								}
+							}
+						}
+					}
+				case 0xbc:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[18]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[19]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[20]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[21]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						case 'p':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'p':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xa9:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'k':
+															if !bytes.HasSuffix(r1(s), caseStepSuffixes[22]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															r, _ = vEndingStepDo(r)
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[23]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				case 'o':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[24]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				case 0xb6:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[25]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				}
+			}
+			if !bytes.HasSuffix(r1(s), caseStepSuffixes[26]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			r, _ = vEndingStepDo(r)
+			return r, true
+		case 'p':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'p':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'k':
+											if !bytes.HasSuffix(r1(s), caseStepSuffixes[27]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = vEndingStepDo(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'k':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[28]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[29]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				case 'e':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[30]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'k':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'n':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if !bytes.HasSuffix(r1(s), caseStepSuffixes[31]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															r, _ = vEndingStepDo(r)
+															return r, true
+														case 'e':
+															if !bytes.HasSuffix(r1(s), caseStepSuffixes[32]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															r, _ = vEndingStepDo(r)
+															return r, true
+														case 'o':
+															if !bytes.HasSuffix(r1(s), caseStepSuffixes[33]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															r, _ = vEndingStepDo(r)
+															return r, true
+														}
+													}
+												}
+											}
+											if !bytes.HasSuffix(r1(s), caseStepSuffixes[34]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = vEndingStepDo(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'o':
+					if !bytes.HasSuffix(r1(s), caseStepSuffixes[35]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = vEndingStepDo(r)
+					return r, true
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[36]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								}
+							}
+						}
+					}
+				case 0xb6:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[37]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				}
+			}
+			if !bytes.HasSuffix(r1(s), caseStepSuffixes[38]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			r, _ = vEndingStepDo(r)
+			return r, true
+		case 'z':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'h':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[39]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'h':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[40]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				case 0xb6:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'h':
+									if !bytes.HasSuffix(r1(s), caseStepSuffixes[41]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = vEndingStepDo(r)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xa1:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'v':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[42]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'v':
+							if !bytes.HasSuffix(r1(s), caseStepSuffixes[43]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = vEndingStepDo(r)
+							return r, true
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func undoubleStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'b':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'b':
+					r := undouble(s, undoubleStepSuffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					r := undouble(s, undoubleStepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					r := undouble(s, undoubleStepSuffixes[2])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'f':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'f':
+					r := undouble(s, undoubleStepSuffixes[3])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'g':
+					r := undouble(s, undoubleStepSuffixes[4])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'j':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'j':
+					r := undouble(s, undoubleStepSuffixes[5])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'k':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'k':
+					r := undouble(s, undoubleStepSuffixes[6])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					r := undouble(s, undoubleStepSuffixes[7])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'm':
+					r := undouble(s, undoubleStepSuffixes[8])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					r := undouble(s, undoubleStepSuffixes[9])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'p':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'p':
+					r := undouble(s, undoubleStepSuffixes[10])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'r':
+					r := undouble(s, undoubleStepSuffixes[11])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							r := undouble(s, undoubleStepSuffixes[12])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 's':
+					r := undouble(s, undoubleStepSuffixes[13])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				case 'z':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'z':
+							r := undouble(s, undoubleStepSuffixes[14])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					r := undouble(s, undoubleStepSuffixes[15])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'v':
+					r := undouble(s, undoubleStepSuffixes[16])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'y':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'g':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'g':
+							r := undouble(s, undoubleStepSuffixes[17])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							r := undouble(s, undoubleStepSuffixes[18])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							r := undouble(s, undoubleStepSuffixes[19])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							r := undouble(s, undoubleStepSuffixes[20])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'z':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							r := undouble(s, undoubleStepSuffixes[21])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 'z':
+					r := undouble(s, undoubleStepSuffixes[22])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func factiveStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0xa1:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(r1(s), factiveStepSuffixes[0]) {
+						return s, false
+					}
+					r := deleteDouble(s, factiveStepSuffixes[0])
+					if r == nil {
+						return s, false
+					}
+					r, _ = undoubleStepDo(r)
+					return r, true
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(r1(s), factiveStepSuffixes[1]) {
+						return s, false
+					}
+					r := deleteDouble(s, factiveStepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					r, _ = undoubleStepDo(r)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func instrumStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r1(s), instrumStepSuffixes[0]) {
+						return s, false
+					}
+					r := deleteDouble(s, instrumStepSuffixes[0])
+					if r == nil {
+						return s, false
+					}
+					r, _ = undoubleStepDo(r)
+					return r, true
+				case 'e':
+					if !bytes.HasSuffix(r1(s), instrumStepSuffixes[1]) {
+						return s, false
+					}
+					r := deleteDouble(s, instrumStepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					r, _ = undoubleStepDo(r)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func ownedStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xa1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r1(s), ownedStepSuffixes[0]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "a"...)
+											return r, true
+										}
+									}
+								case 0xa9:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r1(s), ownedStepSuffixes[1]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "e"...)
+											return r, true
+										}
+									}
+								}
+							}
+							if !bytes.HasSuffix(r1(s), ownedStepSuffixes[2]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'k':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r1(s), ownedStepSuffixes[3]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'e':
+									if !bytes.HasSuffix(r1(s), ownedStepSuffixes[4]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'o':
+									if !bytes.HasSuffix(r1(s), ownedStepSuffixes[5]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 0xa1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r1(s), ownedStepSuffixes[6]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "a"...)
+											return r, true
+										}
+									}
+								case 0xa9:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r1(s), ownedStepSuffixes[7]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "e"...)
+											return r, true
+										}
+									}
+								case 0xb6:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r1(s), ownedStepSuffixes[8]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+							if !bytes.HasSuffix(r1(s), ownedStepSuffixes[9]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), ownedStepSuffixes[10]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "e"...)
+									return r, true
+								}
+							}
+						}
+					}
+					if !bytes.HasSuffix(r1(s), ownedStepSuffixes[11]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func plurOwnerStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'j':
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[0]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[1]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'j':
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[2]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[3]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						case 0xa1:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[4]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "a"...)
+									return r, true
+								}
+							}
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[5]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "e"...)
+									return r, true
+								}
+							}
+						}
+					}
+					if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[6]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'j':
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[7]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+					if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[8]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'j':
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[9]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+					if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[10]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 0xa1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[11]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "a"...)
+							return r, true
+						}
+					}
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[12]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "e"...)
+							return r, true
+						}
+					}
+				}
+			}
+			if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[13]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 'k':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'j':
+													if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[14]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+											if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[15]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										case 0xa9:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[16]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "e"...)
+													return r, true
+												}
+											}
+										}
+									}
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[17]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'j':
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[18]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[19]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'j':
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[20]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[21]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						case 0xa1:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[22]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "a"...)
+									return r, true
+								}
+							}
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[23]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "e"...)
+									return r, true
+								}
+							}
+						}
+					}
+					if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[24]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'j':
+											if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[25]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[26]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'j':
+											if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[27]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[28]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 0xa1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[29]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "a"...)
+											return r, true
+										}
+									}
+								case 0xa9:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[30]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "e"...)
+											return r, true
+										}
+									}
+								}
+							}
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[31]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'j':
+													if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[32]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+											if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[33]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										case 0xa1:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[34]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "a"...)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'j':
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[35]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[36]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'j':
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[37]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[38]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						case 0xa1:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[39]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "a"...)
+									return r, true
+								}
+							}
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[40]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "e"...)
+									return r, true
+								}
+							}
+						}
+					}
+					if !bytes.HasSuffix(r1(s), plurOwnerStepSuffixes[41]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func pluralStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'k':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r1(s), pluralStepSuffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 'e':
+					if !bytes.HasSuffix(r1(s), pluralStepSuffixes[1]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 'o':
+					if !bytes.HasSuffix(r1(s), pluralStepSuffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 0xa1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), pluralStepSuffixes[3]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "a"...)
+							return r, true
+						}
+					}
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), pluralStepSuffixes[4]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "e"...)
+							return r, true
+						}
+					}
+				case 0xb6:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), pluralStepSuffixes[5]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+			if !bytes.HasSuffix(r1(s), pluralStepSuffixes[6]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		}
+	}
+	return s, false
+}
+
+func singOwnerStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'j':
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+			if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[1]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 'e':
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[3]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 'o':
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[4]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 0xa1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[5]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "a"...)
+							return r, true
+						}
+					}
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[6]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "e"...)
+							return r, true
+						}
+					}
+				case 0xb6:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[7]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+			if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[8]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'j':
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[9]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+			if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[10]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 'k':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'u':
+							if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[11]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						case 0xa1:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[12]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "a"...)
+									return r, true
+								}
+							}
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[13]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "e"...)
+									return r, true
+								}
+							}
+						case 0xbc:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[14]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[15]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'j':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xa1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[16]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "a"...)
+											return r, true
+										}
+									}
+								}
+							}
+							if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[17]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[18]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 0xbc:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'j':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xa9:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[19]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "e"...)
+													return r, true
+												}
+											}
+										}
+									}
+									if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[20]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+							if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[21]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[22]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 'e':
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[23]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 'o':
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[24]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				case 0xa1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[25]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "a"...)
+							return r, true
+						}
+					}
+				case 0xa9:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[26]) {
+								return s, false
+							}
+							r := append(s[:len(s)-3], "e"...)
+							return r, true
+						}
+					}
+				}
+			}
+			if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[27]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 'o':
+			if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[28]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 0xa1:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[29]) {
+						return s, false
+					}
+					r := append(s[:len(s)-2], "a"...)
+					return r, true
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(r1(s), singOwnerStepSuffixes[30]) {
+						return s, false
+					}
+					r := append(s[:len(s)-2], "e"...)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
@@ -1,3 +1,6 @@
+//go:build gofuzz
+// +build gofuzz
+
 /*  Copyright (C) 2018 Alexandru Cojocaru
 
 This program is free software: you can redistribute it and/or modify
@@ -13,8 +16,6 @@ GNU Affero General Public License for more details.
 You should have received a copy of the GNU Affero General Public License
 along with this program.  If not, see <https://www.gnu.org/licenses/>. */
 
-// +build gofuzz
-
 package porter2italian
 
 func Fuzz(data []byte) int {
@@ -20,9 +20,12 @@ import (
 	"strings"
 	"unicode/utf8"
 
-	. "xojoc.pw/nlp/stem/internal/porter2"
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
 )
 
+//go:generate go run ../../porter2/porter2gen/main.go
+
 // http://snowballstem.org/algorithms/italian/stemmer.html
 
 var vowels = "aeiouàèìòù"
@@ -88,75 +91,92 @@ func step0CB(s []byte, suffix []byte) []byte {
 	} else if bytes.HasSuffix(v, []byte("ar")) || bytes.HasSuffix(v, []byte("er")) || bytes.HasSuffix(v, []byte("ir")) {
 		return append(m, 'e')
 	}
-	return s
+	return nil
 }
 
-var step0Step = NewStep([]Suffix{
-	{"ci gli la le li lo mi ne si ti vi sene gliela gliele glieli glielo gliene mela mele meli melo mene tela tele teli telo tene cela cele celi celo cene vela vele veli velo vene", step0CB, rv, nil, nil},
+var step0Step = MustNewStep([]Suffix{
+	{Suffixes: "ci gli la le li lo mi ne si ti vi sene gliela gliele glieli glielo gliene mela mele meli melo mene tela tele teli telo tene cela cele celi celo cene vela vele veli velo vene", Callback: step0CB, MatchRegion: rv, Region: "RV"},
 })
 
-func step0(s []byte) []byte {
-	return step0Step.Apply(s)
+func step0(s []byte, t *Tracer) []byte {
+	return t.Hook(step0Step).Apply(s)
 }
 
-var step1Step = NewStep([]Suffix{
-	{"anza anze ico ici ica ice iche ichi ismo ismi abile abili ibile ibili ista iste isti istà istè istì oso osi osa ose mente atrice atrici ante anti", Delete, nil, r2, nil},
-	{"azione azioni atore atori", Delete, nil, r2, []Suffix{{"ic", Delete, nil, r2, nil}}},
-	{"logia logie", Truncate("log"), nil, r2, nil},
-	{"uzione uzioni usione usioni", Truncate("u"), nil, r2, nil},
-	{"enza enze", Replace("ente"), nil, r2, nil},
-	{"amento amenti imento imenti", Delete, nil, rv, nil},
-	{"amente", Delete, nil, r1,
-		[]Suffix{
-			{"iv", Delete, nil, r2, []Suffix{{"at", Delete, nil, r2, nil}}},
-			{"os ic abil", Delete, nil, r2, nil},
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "anza anze ico ici ica ice iche ichi ismo ismi abile abili ibile ibili ista iste isti istà istè istì oso osi osa ose mente atrice atrici ante anti", Callback: Delete, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "azione azioni atore atori", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "ic", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+	{Suffixes: "logia logie", Callback: Truncate("log"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "uzione uzioni usione usioni", Callback: Truncate("u"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "enza enze", Callback: Replace("ente"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "amento amenti imento imenti", Callback: Delete, ActionRegion: rv, Region: "RV"},
+	{Suffixes: "amente", Callback: Delete, ActionRegion: r1, Region: "R1",
+		SuffixCallbacks: []Suffix{
+			{Suffixes: "iv", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "at", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+			{Suffixes: "os ic abil", Callback: Delete, ActionRegion: r2, Region: "R2"},
 		},
 	},
-	{"ità", Delete, nil, r2, []Suffix{{"abil ic iv", Delete, nil, r2, nil}}},
-	{"ivo ivi iva ive", Delete, nil, r2, []Suffix{{"at", Delete, nil, r2, []Suffix{{"ic", Delete, nil, r2, nil}}}}},
+	{Suffixes: "ità", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "abil ic iv", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+	{Suffixes: "ivo ivi iva ive", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "at", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "ic", Callback: Delete, ActionRegion: r2, Region: "R2"}}}}},
 })
 
-func step1(s []byte) []byte {
-	return step1Step.Apply(s)
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
 
 }
 
-var step2Step = NewStep([]Suffix{
-	{"ammo ando ano are arono asse assero assi assimo ata ate ati ato ava avamo avano avate avi avo emmo enda ende endi endo erà erai eranno ere erebbe erebbero erei eremmo eremo ereste eresti erete erò erono essero ete eva evamo evano evate evi evo Yamo iamo immo irà irai iranno ire irebbe irebbero irei iremmo iremo ireste iresti irete irò irono isca iscano isce isci isco iscono issero ita ite iti ito iva ivamo ivano ivate ivi ivo ono uta ute uti uto ar ir", Delete, rv, nil, nil},
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "ammo ando ano are arono asse assero assi assimo ata ate ati ato ava avamo avano avate avi avo emmo enda ende endi endo erà erai eranno ere erebbe erebbero erei eremmo eremo ereste eresti erete erò erono essero ete eva evamo evano evate evi evo Yamo iamo immo irà irai iranno ire irebbe irebbero irei iremmo iremo ireste iresti irete irò irono isca iscano isce isci isco iscono issero ita ite iti ito iva ivamo ivano ivate ivi ivo ono uta ute uti uto ar ir", Callback: Delete, MatchRegion: rv, Region: "RV"},
 })
 
-func step2(s []byte) []byte {
-	return step2Step.Apply(s)
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
 }
 
-var step3aStep = NewStep([]Suffix{
-	{"a e i o à è ì ò", Delete, nil, rv, []Suffix{{"i", Delete, rv, nil, nil}}},
+var step3aStep = MustNewStep([]Suffix{
+	{Suffixes: "a e i o à è ì ò", Callback: Delete, ActionRegion: rv, Region: "RV", SuffixCallbacks: []Suffix{{Suffixes: "i", Callback: Delete, MatchRegion: rv, Region: "RV"}}},
 })
 
-func step3a(s []byte) []byte {
-	return step3aStep.Apply(s)
+func step3a(s []byte, t *Tracer) []byte {
+	return t.Hook(step3aStep).Apply(s)
 }
 
-var step3bStep = NewStep([]Suffix{
-	{"ch", Truncate("c"), nil, rv, nil},
-	{"gh", Truncate("g"), nil, rv, nil},
+var step3bStep = MustNewStep([]Suffix{
+	{Suffixes: "ch", Callback: Truncate("c"), ActionRegion: rv, Region: "RV"},
+	{Suffixes: "gh", Callback: Truncate("g"), ActionRegion: rv, Region: "RV"},
 })
 
-func step3b(s []byte) []byte {
-	return step3bStep.Apply(s)
+func step3b(s []byte, t *Tracer) []byte {
+	return t.Hook(step3bStep).Apply(s)
 }
 
 func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
 	s = normalize(s)
-	s = step0(s)
-	s1 := step1(s)
+	t.Record("normalize", s)
+	s = step0(s, t)
+	t.Record("step0", s)
+	s1 := step1(s, t)
 	if bytes.Equal(s1, s) {
-		s = step2(s)
+		s = step2(s, t)
+		t.Record("step2", s)
 	} else {
 		s = s1
+		t.Record("step1", s)
 	}
-	s = step3a(s)
-	s = step3b(s)
+	s = step3a(s, t)
+	t.Record("step3a", s)
+	s = step3b(s, t)
+	t.Record("step3b", s)
 	for i, b := range s {
 		if b == 'I' {
 			s[i] = 'i'
@@ -164,6 +184,7 @@ func StemBytes(s []byte) []byte {
 			s[i] = 'u'
 		}
 	}
+	t.Record("postlude", s)
 	return s
 }
 
@@ -172,7 +193,7 @@ func StemString(s string) string {
 }
 
 func NormalizeBytes(b []byte) []byte {
-	return bytes.ToLower(bytes.TrimSpace(b))
+	return textnorm.Bytes(b)
 }
 
 func NormalizeString(s string) string {
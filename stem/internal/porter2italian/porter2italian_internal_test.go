@@ -15,7 +15,11 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>. */
 
 package porter2italian
 
-import "testing"
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
 
 func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
 	for i := 0; i < len(pairs); i += 2 {
@@ -27,6 +31,10 @@ func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
 
 }
 
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
 func TestNormalize(t *testing.T) {
 	pairs := []string{"acciai", "acciai", "colloquio", "colloqUio"}
 	test(t, normalize, pairs)
@@ -49,20 +57,20 @@ func TestRv(t *testing.T) {
 
 func TestStep0(t *testing.T) {
 	pairs := []string{"guardandogli", "guardando", "accomodarci", "accomodare"}
-	test(t, step0, pairs)
+	test(t, untraced(step0), pairs)
 }
 
 func TestStep2(t *testing.T) {
 	pairs := []string{"alzavano", "alzav"}
-	test(t, step2, pairs)
+	test(t, untraced(step2), pairs)
 }
 
 func TestStep3a(t *testing.T) {
 	pairs := []string{"crocchi", "crocch", "crocchio", "crocch", "abbia", "abbi", "acciai", "accia"}
-	test(t, step3a, pairs)
+	test(t, untraced(step3a), pairs)
 }
 
 func TestStep3b(t *testing.T) {
 	pairs := []string{"crocch", "crocc"}
-	test(t, step3b, pairs)
+	test(t, untraced(step3b), pairs)
 }
@@ -22,8 +22,8 @@ import (
 	"strings"
 	"testing"
 
-	"gitlab.com/gonlp/stem/porter2italian"
 	"gitlab.com/xojoc/util"
+	"xojoc.pw/nlp/stem/internal/porter2italian"
 )
 
 func TestStemBytes(t *testing.T) {
@@ -62,6 +62,7 @@ func loadWords() {
 
 func BenchmarkStemBytes(b *testing.B) {
 	loadWords()
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		for _, w := range words {
@@ -0,0 +1,2629 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2italian
+
+import (
+	"bytes"
+)
+
+func init() {
+	step0Step.SetDo(step0StepDo)
+	step1Step.SetDo(step1StepDo)
+	step2Step.SetDo(step2StepDo)
+	step3aStep.SetDo(step3aStepDo)
+	step3bStep.SetDo(step3bStepDo)
+}
+
+var step0StepSuffixes = [...][]byte{
+	[]byte("cela"),
+	[]byte("gliela"),
+	[]byte("mela"),
+	[]byte("tela"),
+	[]byte("vela"),
+	[]byte("la"),
+	[]byte("cele"),
+	[]byte("gliele"),
+	[]byte("mele"),
+	[]byte("tele"),
+	[]byte("vele"),
+	[]byte("le"),
+	[]byte("cene"),
+	[]byte("gliene"),
+	[]byte("mene"),
+	[]byte("sene"),
+	[]byte("tene"),
+	[]byte("vene"),
+	[]byte("ne"),
+	[]byte("ci"),
+	[]byte("celi"),
+	[]byte("glieli"),
+	[]byte("meli"),
+	[]byte("teli"),
+	[]byte("veli"),
+	[]byte("gli"),
+	[]byte("li"),
+	[]byte("mi"),
+	[]byte("si"),
+	[]byte("ti"),
+	[]byte("vi"),
+	[]byte("celo"),
+	[]byte("glielo"),
+	[]byte("melo"),
+	[]byte("telo"),
+	[]byte("velo"),
+	[]byte("lo"),
+}
+
+var step1StepNested1Suffixes = [...][]byte{
+	[]byte("ic"),
+}
+
+var step1StepNested6Nested0Suffixes = [...][]byte{
+	[]byte("at"),
+}
+
+var step1StepNested6Suffixes = [...][]byte{
+	[]byte("ic"),
+	[]byte("abil"),
+	[]byte("os"),
+	[]byte("iv"),
+}
+
+var step1StepNested7Suffixes = [...][]byte{
+	[]byte("ic"),
+	[]byte("abil"),
+	[]byte("iv"),
+}
+
+var step1StepNested8Nested0Suffixes = [...][]byte{
+	[]byte("ic"),
+}
+
+var step1StepNested8Suffixes = [...][]byte{
+	[]byte("at"),
+}
+
+var step1StepSuffixes = [...][]byte{
+	[]byte("ica"),
+	[]byte("logia"),
+	[]byte("osa"),
+	[]byte("ista"),
+	[]byte("iva"),
+	[]byte("anza"),
+	[]byte("enza"),
+	[]byte("atrice"),
+	[]byte("ice"),
+	[]byte("iche"),
+	[]byte("logie"),
+	[]byte("abile"),
+	[]byte("ibile"),
+	[]byte("usione"),
+	[]byte("azione"),
+	[]byte("uzione"),
+	[]byte("atore"),
+	[]byte("ose"),
+	[]byte("ante"),
+	[]byte("amente"),
+	[]byte("mente"),
+	[]byte("iste"),
+	[]byte("ive"),
+	[]byte("anze"),
+	[]byte("enze"),
+	[]byte("atrici"),
+	[]byte("ici"),
+	[]byte("ichi"),
+	[]byte("abili"),
+	[]byte("ibili"),
+	[]byte("ismi"),
+	[]byte("usioni"),
+	[]byte("azioni"),
+	[]byte("uzioni"),
+	[]byte("atori"),
+	[]byte("osi"),
+	[]byte("anti"),
+	[]byte("amenti"),
+	[]byte("imenti"),
+	[]byte("isti"),
+	[]byte("ivi"),
+	[]byte("ico"),
+	[]byte("ismo"),
+	[]byte("oso"),
+	[]byte("amento"),
+	[]byte("imento"),
+	[]byte("ivo"),
+	[]byte("ità"),
+	[]byte("istà"),
+	[]byte("istè"),
+	[]byte("istì"),
+}
+
+var step2StepSuffixes = [...][]byte{
+	[]byte("isca"),
+	[]byte("enda"),
+	[]byte("ata"),
+	[]byte("ita"),
+	[]byte("uta"),
+	[]byte("ava"),
+	[]byte("eva"),
+	[]byte("iva"),
+	[]byte("erebbe"),
+	[]byte("irebbe"),
+	[]byte("isce"),
+	[]byte("ende"),
+	[]byte("are"),
+	[]byte("ere"),
+	[]byte("ire"),
+	[]byte("asse"),
+	[]byte("avate"),
+	[]byte("evate"),
+	[]byte("ivate"),
+	[]byte("ate"),
+	[]byte("erete"),
+	[]byte("irete"),
+	[]byte("ete"),
+	[]byte("ite"),
+	[]byte("ereste"),
+	[]byte("ireste"),
+	[]byte("ute"),
+	[]byte("erai"),
+	[]byte("irai"),
+	[]byte("isci"),
+	[]byte("endi"),
+	[]byte("erei"),
+	[]byte("irei"),
+	[]byte("assi"),
+	[]byte("ati"),
+	[]byte("iti"),
+	[]byte("eresti"),
+	[]byte("iresti"),
+	[]byte("uti"),
+	[]byte("avi"),
+	[]byte("evi"),
+	[]byte("ivi"),
+	[]byte("isco"),
+	[]byte("ando"),
+	[]byte("endo"),
+	[]byte("Yamo"),
+	[]byte("iamo"),
+	[]byte("avamo"),
+	[]byte("evamo"),
+	[]byte("ivamo"),
+	[]byte("eremo"),
+	[]byte("iremo"),
+	[]byte("assimo"),
+	[]byte("ammo"),
+	[]byte("eremmo"),
+	[]byte("iremmo"),
+	[]byte("emmo"),
+	[]byte("immo"),
+	[]byte("iscano"),
+	[]byte("avano"),
+	[]byte("evano"),
+	[]byte("ivano"),
+	[]byte("ano"),
+	[]byte("eranno"),
+	[]byte("iranno"),
+	[]byte("iscono"),
+	[]byte("arono"),
+	[]byte("erono"),
+	[]byte("irono"),
+	[]byte("ono"),
+	[]byte("erebbero"),
+	[]byte("irebbero"),
+	[]byte("assero"),
+	[]byte("essero"),
+	[]byte("issero"),
+	[]byte("ato"),
+	[]byte("ito"),
+	[]byte("uto"),
+	[]byte("avo"),
+	[]byte("evo"),
+	[]byte("ivo"),
+	[]byte("ar"),
+	[]byte("ir"),
+	[]byte("erà"),
+	[]byte("irà"),
+	[]byte("erò"),
+	[]byte("irò"),
+}
+
+var step3aStepNested0Suffixes = [...][]byte{
+	[]byte("i"),
+}
+
+var step3aStepSuffixes = [...][]byte{
+	[]byte("a"),
+	[]byte("e"),
+	[]byte("i"),
+	[]byte("o"),
+	[]byte("à"),
+	[]byte("è"),
+	[]byte("ì"),
+	[]byte("ò"),
+}
+
+var step3bStepSuffixes = [...][]byte{
+	[]byte("ch"),
+	[]byte("gh"),
+}
+
+func step0StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[0]) {
+										r := step0CB(s, step0StepSuffixes[0])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'g':
+													if bytes.HasSuffix(rv(s), step0StepSuffixes[1]) {
+														r := step0CB(s, step0StepSuffixes[1])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 'm':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[2]) {
+										r := step0CB(s, step0StepSuffixes[2])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 't':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[3]) {
+										r := step0CB(s, step0StepSuffixes[3])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'v':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[4]) {
+										r := step0CB(s, step0StepSuffixes[4])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[5]) {
+						r := step0CB(s, step0StepSuffixes[5])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[6]) {
+										r := step0CB(s, step0StepSuffixes[6])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'g':
+													if bytes.HasSuffix(rv(s), step0StepSuffixes[7]) {
+														r := step0CB(s, step0StepSuffixes[7])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 'm':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[8]) {
+										r := step0CB(s, step0StepSuffixes[8])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 't':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[9]) {
+										r := step0CB(s, step0StepSuffixes[9])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'v':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[10]) {
+										r := step0CB(s, step0StepSuffixes[10])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[11]) {
+						r := step0CB(s, step0StepSuffixes[11])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[12]) {
+										r := step0CB(s, step0StepSuffixes[12])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'g':
+													if bytes.HasSuffix(rv(s), step0StepSuffixes[13]) {
+														r := step0CB(s, step0StepSuffixes[13])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 'm':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[14]) {
+										r := step0CB(s, step0StepSuffixes[14])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 's':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[15]) {
+										r := step0CB(s, step0StepSuffixes[15])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 't':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[16]) {
+										r := step0CB(s, step0StepSuffixes[16])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'v':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[17]) {
+										r := step0CB(s, step0StepSuffixes[17])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[18]) {
+						r := step0CB(s, step0StepSuffixes[18])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[19]) {
+						r := step0CB(s, step0StepSuffixes[19])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[20]) {
+										r := step0CB(s, step0StepSuffixes[20])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'g':
+													if bytes.HasSuffix(rv(s), step0StepSuffixes[21]) {
+														r := step0CB(s, step0StepSuffixes[21])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 'm':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[22]) {
+										r := step0CB(s, step0StepSuffixes[22])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 't':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[23]) {
+										r := step0CB(s, step0StepSuffixes[23])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'v':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[24]) {
+										r := step0CB(s, step0StepSuffixes[24])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 'g':
+							if bytes.HasSuffix(rv(s), step0StepSuffixes[25]) {
+								r := step0CB(s, step0StepSuffixes[25])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[26]) {
+						r := step0CB(s, step0StepSuffixes[26])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'm':
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[27]) {
+						r := step0CB(s, step0StepSuffixes[27])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 's':
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[28]) {
+						r := step0CB(s, step0StepSuffixes[28])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 't':
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[29]) {
+						r := step0CB(s, step0StepSuffixes[29])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'v':
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[30]) {
+						r := step0CB(s, step0StepSuffixes[30])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[31]) {
+										r := step0CB(s, step0StepSuffixes[31])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'g':
+													if bytes.HasSuffix(rv(s), step0StepSuffixes[32]) {
+														r := step0CB(s, step0StepSuffixes[32])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 'm':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[33]) {
+										r := step0CB(s, step0StepSuffixes[33])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 't':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[34]) {
+										r := step0CB(s, step0StepSuffixes[34])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'v':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[35]) {
+										r := step0CB(s, step0StepSuffixes[35])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[36]) {
+						r := step0CB(s, step0StepSuffixes[36])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested1Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested1Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested6Nested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested6Nested0Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested6Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[1]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[3]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step1StepNested6Nested0Do(r)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested7Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested7Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepNested7Suffixes[1]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested7Suffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested8Nested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested8Nested0Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested8Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested8Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step1StepNested8Nested0Do(r)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[0]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'g':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[1]) {
+												return s, false
+											}
+											r := s[:len(s)-2]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[2]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[3]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[4]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested8Do(r)
+							return r, true
+						}
+					}
+				case 'z':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[5]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'e':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[6]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "ente"...)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 't':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[7]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[8]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'h':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[9]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'g':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[10]) {
+												return s, false
+											}
+											r := s[:len(s)-2]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[11]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[12]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'u':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[13]) {
+														return s, false
+													}
+													r := s[:len(s)-5]
+													return r, true
+												}
+											}
+										case 'z':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[14]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested1Do(r)
+													return r, true
+												case 'u':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[15]) {
+														return s, false
+													}
+													r := s[:len(s)-5]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[16]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested1Do(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[17]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[18]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'm':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r1(s), step1StepSuffixes[19]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested6Do(r)
+													return r, true
+												}
+											}
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[20]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[21]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[22]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested8Do(r)
+							return r, true
+						}
+					}
+				case 'z':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[23]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'e':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[24]) {
+										return s, false
+									}
+									r := append(s[:len(s)-4], "ente"...)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 't':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[25]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[26]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'h':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[27]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[28]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[29]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[30]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'u':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[31]) {
+														return s, false
+													}
+													r := s[:len(s)-5]
+													return r, true
+												}
+											}
+										case 'z':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[32]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested1Do(r)
+													return r, true
+												case 'u':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[33]) {
+														return s, false
+													}
+													r := s[:len(s)-5]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[34]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested1Do(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[35]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[36]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'm':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(rv(s), step1StepSuffixes[37]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												case 'i':
+													if !bytes.HasSuffix(rv(s), step1StepSuffixes[38]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[39]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[40]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested8Do(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[41]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[42]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[43]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'm':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(rv(s), step1StepSuffixes[44]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												case 'i':
+													if !bytes.HasSuffix(rv(s), step1StepSuffixes[45]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[46]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested8Do(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 0xa0:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[47]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested7Do(r)
+									return r, true
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[48]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xa8:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[49]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xac:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[50]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[0]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[1]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[2]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[3]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'u':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[4]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[5]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'e':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[6]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[7]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'b':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[8]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[9]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[10]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[11]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[12]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'e':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[13]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[14]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[15]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'v':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[16]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[17]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[18]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[19]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[20]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[21]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[22]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[23]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[24]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[25]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'u':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[26]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[27]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[28]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[29]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[30]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[31]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[32]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[33]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[34]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[35]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[36]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[37]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'u':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[38]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[39]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'e':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[40]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[41]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[42]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[43]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[44]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'Y':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[45]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[46]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'v':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[47]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[48]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[49]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[50]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[51]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[52]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[53]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[54]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[55]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[56]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[57]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[58]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 'v':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[59]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[60]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[61]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[62]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[63]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[64]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[65]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[66]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[67]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[68]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[69]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'b':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'r':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'e':
+																	if bytes.HasSuffix(rv(s), step2StepSuffixes[70]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																case 'i':
+																	if bytes.HasSuffix(rv(s), step2StepSuffixes[71]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[72]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[73]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[74]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[75]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[76]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'u':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[77]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[78]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'e':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[79]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[80]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[81]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'i':
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[82]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xa0:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[83]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[84]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb2:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[85]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[86]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3aStepNested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'i':
+			if bytes.HasSuffix(rv(s), step3aStepNested0Suffixes[0]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		}
+	}
+	return s, false
+}
+
+func step3aStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if !bytes.HasSuffix(rv(s), step3aStepSuffixes[0]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			r, _ = step3aStepNested0Do(r)
+			return r, true
+		case 'e':
+			if !bytes.HasSuffix(rv(s), step3aStepSuffixes[1]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			r, _ = step3aStepNested0Do(r)
+			return r, true
+		case 'i':
+			if !bytes.HasSuffix(rv(s), step3aStepSuffixes[2]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			r, _ = step3aStepNested0Do(r)
+			return r, true
+		case 'o':
+			if !bytes.HasSuffix(rv(s), step3aStepSuffixes[3]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			r, _ = step3aStepNested0Do(r)
+			return r, true
+		case 0xa0:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(rv(s), step3aStepSuffixes[4]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step3aStepNested0Do(r)
+					return r, true
+				}
+			}
+		case 0xa8:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(rv(s), step3aStepSuffixes[5]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step3aStepNested0Do(r)
+					return r, true
+				}
+			}
+		case 0xac:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(rv(s), step3aStepSuffixes[6]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step3aStepNested0Do(r)
+					return r, true
+				}
+			}
+		case 0xb2:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(rv(s), step3aStepSuffixes[7]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step3aStepNested0Do(r)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3bStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'h':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if !bytes.HasSuffix(rv(s), step3bStepSuffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-1]
+					return r, true
+				case 'g':
+					if !bytes.HasSuffix(rv(s), step3bStepSuffixes[1]) {
+						return s, false
+					}
+					r := s[:len(s)-1]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
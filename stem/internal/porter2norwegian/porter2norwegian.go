@@ -0,0 +1,116 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2norwegian
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/norwegian/stemmer.html
+
+const vowels = "aeiouyæåø"
+
+var r1 = ScandinavianR1(vowels)
+
+func step1S(s []byte, suffix []byte) []byte {
+	const validSEnding = "bcdfghjlmnoprtvyz"
+	m := s[:len(s)-len(suffix)]
+	if len(m) == 0 {
+		return nil
+	}
+	if strings.IndexByte(validSEnding, m[len(m)-1]) >= 0 {
+		return m
+	}
+	// k not preceded by a vowel is also a valid s-ending.
+	if m[len(m)-1] == 'k' {
+		if r, l := utf8.DecodeLastRune(m[:len(m)-1]); l > 0 && !strings.ContainsRune(vowels, r) {
+			return m
+		}
+	}
+	return nil
+}
+
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "a e ede ande ende ane ene hetene en heten ar er heter as es edes endes enes hetenes ens hetens ers ets et het ast", Callback: Delete, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "erte ert", Callback: Replace("er"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "s", Callback: step1S, MatchRegion: r1, Region: "R1"},
+})
+
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
+}
+
+func undouble(s []byte, suffix []byte) []byte {
+	return s[:len(s)-1]
+}
+
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "dt vt", Callback: undouble, MatchRegion: r1, Region: "R1"},
+})
+
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
+}
+
+var step3Step = MustNewStep([]Suffix{
+	{Suffixes: "leg eleg ig eig lig elig els lov elov slov hetslov", Callback: Delete, MatchRegion: r1, Region: "R1"},
+})
+
+func step3(s []byte, t *Tracer) []byte {
+	return t.Hook(step3Step).Apply(s)
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = step1(s, t)
+	t.Record("step1", s)
+	s = step2(s, t)
+	t.Record("step2", s)
+	s = step3(s, t)
+	t.Record("step3", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+// form keeps æ, which is a letter of the alphabet.
+var form = textnorm.Form{Letters: "æ"}
+
+func NormalizeBytes(b []byte) []byte {
+	return form.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
@@ -0,0 +1,56 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2norwegian
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestR1(t *testing.T) {
+	pairs := []string{"havnefogd", "nefogd", "øker", "r"}
+	test(t, r1, pairs)
+}
+
+func TestStep1(t *testing.T) {
+	pairs := []string{"havnedistriktene", "havnedistrikt", "opplevelse", "opplevels", "bekreftet", "bekreft", "kommunens", "kommun", "virkerte", "virker", "parks", "park", "sekks", "sekk", "peks", "peks"}
+	test(t, untraced(step1), pairs)
+}
+
+func TestStep2(t *testing.T) {
+	pairs := []string{"opplevdt", "opplevd", "havnefogd", "havnefogd"}
+	test(t, untraced(step2), pairs)
+}
+
+func TestStep3(t *testing.T) {
+	pairs := []string{"opplevels", "opplev", "kjærlig", "kjær"}
+	test(t, untraced(step3), pairs)
+}
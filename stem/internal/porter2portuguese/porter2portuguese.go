@@ -0,0 +1,183 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2portuguese
+
+import (
+	"bytes"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/portuguese/stemmer.html
+
+const vowels = "aeiouáéíóúâêô"
+
+var (
+	r1 = R1(vowels)
+	r2 = R2(vowels)
+	rv = SpanishRV(vowels)
+)
+
+// ã and õ are two bytes in UTF-8 just like a~ and o~,
+// so they can be replaced in place.
+var (
+	aTilde = []byte("ã")
+	oTilde = []byte("õ")
+)
+
+func normalize(s []byte) []byte {
+	for i := 0; i+1 < len(s); i++ {
+		if s[i] == aTilde[0] && s[i+1] == aTilde[1] {
+			s[i], s[i+1] = 'a', '~'
+		} else if s[i] == oTilde[0] && s[i+1] == oTilde[1] {
+			s[i], s[i+1] = 'o', '~'
+		}
+	}
+	return s
+}
+
+func postlude(s []byte) []byte {
+	for i := 1; i < len(s); i++ {
+		if s[i] != '~' {
+			continue
+		}
+		if s[i-1] == 'a' {
+			s[i-1], s[i] = aTilde[0], aTilde[1]
+		} else if s[i-1] == 'o' {
+			s[i-1], s[i] = oTilde[0], oTilde[1]
+		}
+	}
+	return s
+}
+
+func step1Ira(s []byte, suffix []byte) []byte {
+	if bytes.HasSuffix(s[:len(s)-len(suffix)], []byte("e")) {
+		return Replace("ir")(s, suffix)
+	}
+	return nil
+}
+
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "eza ezas ico ica icos icas ismo ismos ável ível ista istas oso osa osos osas amento amentos imento imentos adora ador aça~o adoras adores aço~es ante antes ância", Callback: Delete, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "logia logias", Callback: Replace("log"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "uça~o uço~es", Callback: Truncate("u"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "ência ências", Callback: Replace("ente"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "amente", Callback: Delete, ActionRegion: r1, Region: "R1",
+		SuffixCallbacks: []Suffix{
+			{Suffixes: "iv", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "at", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+			{Suffixes: "os ic ad", Callback: Delete, ActionRegion: r2, Region: "R2"},
+		},
+	},
+	{Suffixes: "mente", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "ante avel ível", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+	{Suffixes: "idade idades", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "abil ic iv", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+	{Suffixes: "iva ivo ivas ivos", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "at", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+	{Suffixes: "ira iras", Callback: step1Ira, ActionRegion: rv, Region: "RV"},
+})
+
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
+}
+
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "ada ida ia aria eria iria ará ara erá era irá ava asse esse isse aste este iste ei arei erei irei am iam ariam eriam iriam aram eram iram avam em arem erem irem assem essem issem ado ido ando endo indo ara~o era~o ira~o ar er ir as adas idas ias arias erias irias arás aras erás eras irás avas es ardes erdes irdes ares eres ires asses esses isses astes estes istes is ais eis íeis aríeis eríeis iríeis áreis areis éreis ereis íreis ireis ásseis ésseis ísseis áveis ados idos ámos amos íamos aríamos eríamos iríamos áramos éramos íramos ávamos emos aremos eremos iremos ássemos êssemos íssemos imos armos ermos irmos eu iu ou ira iras", Callback: Delete, MatchRegion: rv, Region: "RV"},
+})
+
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
+}
+
+func step3(s []byte) []byte {
+	if bytes.HasSuffix(rv(s), []byte("i")) && bytes.HasSuffix(s, []byte("ci")) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+var step4Step = MustNewStep([]Suffix{
+	{Suffixes: "os a i o á í ó", Callback: Delete, ActionRegion: rv, Region: "RV"},
+})
+
+func step4(s []byte, t *Tracer) []byte {
+	return t.Hook(step4Step).Apply(s)
+}
+
+func step5E(s []byte, suffix []byte) []byte {
+	s = Delete(s, suffix)
+	if (bytes.HasSuffix(s, []byte("gu")) || bytes.HasSuffix(s, []byte("ci"))) &&
+		len(rv(s)) > 0 {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+var step5Step = MustNewStep([]Suffix{
+	{Suffixes: "e é ê", Callback: step5E, ActionRegion: rv, Region: "RV"},
+	{Suffixes: "ç", Callback: Replace("c")},
+})
+
+func step5(s []byte, t *Tracer) []byte {
+	return t.Hook(step5Step).Apply(s)
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = normalize(s)
+	t.Record("normalize", s)
+	n := len(s)
+	s = step1(s, t)
+	t.Record("step1", s)
+	if len(s) == n {
+		s = step2(s, t)
+		t.Record("step2", s)
+	}
+	if len(s) != n {
+		s = step3(s)
+		t.Record("step3", s)
+	} else {
+		s = step4(s, t)
+		t.Record("step4", s)
+	}
+	s = step5(s, t)
+	t.Record("step5", s)
+	s = postlude(s)
+	t.Record("postlude", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
@@ -0,0 +1,66 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2portuguese
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestNormalize(t *testing.T) {
+	pairs := []string{"nação", "naça~o", "corações", "coraço~es", "mãe", "ma~e"}
+	test(t, normalize, pairs)
+}
+
+func TestPostlude(t *testing.T) {
+	pairs := []string{"naça~o", "nação", "coraço~es", "corações", "~o", "~o"}
+	test(t, postlude, pairs)
+}
+
+func TestRv(t *testing.T) {
+	pairs := []string{"macho", "ho", "oliva", "va", "trabajo", "bajo", "áureo", "eo"}
+	test(t, rv, pairs)
+}
+
+func TestStep1(t *testing.T) {
+	pairs := []string{"informaça~o", "inform", "antropologia", "antropolog", "cadeira", "cadeir", "naturalmente", "natural"}
+	test(t, untraced(step1), pairs)
+}
+
+func TestStep2(t *testing.T) {
+	pairs := []string{"cantavam", "cant", "ficaríamos", "fic", "conheci", "conheci"}
+	test(t, untraced(step2), pairs)
+}
+
+func TestStep5(t *testing.T) {
+	pairs := []string{"consegue", "conseg", "conhece", "conhec", "açúcar", "açúcar", "laço", "laço", "faç", "fac"}
+	test(t, untraced(step5), pairs)
+}
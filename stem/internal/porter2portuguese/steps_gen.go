@@ -0,0 +1,2329 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2portuguese
+
+import (
+	"bytes"
+)
+
+func init() {
+	step1Step.SetDo(step1StepDo)
+	step2Step.SetDo(step2StepDo)
+	step4Step.SetDo(step4StepDo)
+	step5Step.SetDo(step5StepDo)
+}
+
+var step1StepNested4Nested0Suffixes = [...][]byte{
+	[]byte("at"),
+}
+
+var step1StepNested4Suffixes = [...][]byte{
+	[]byte("ic"),
+	[]byte("ad"),
+	[]byte("os"),
+	[]byte("iv"),
+}
+
+var step1StepNested5Suffixes = [...][]byte{
+	[]byte("ante"),
+	[]byte("avel"),
+	[]byte("ível"),
+}
+
+var step1StepNested6Suffixes = [...][]byte{
+	[]byte("ic"),
+	[]byte("abil"),
+	[]byte("iv"),
+}
+
+var step1StepNested7Suffixes = [...][]byte{
+	[]byte("at"),
+}
+
+var step1StepSuffixes = [...][]byte{
+	[]byte("ica"),
+	[]byte("ância"),
+	[]byte("ência"),
+	[]byte("logia"),
+	[]byte("ira"),
+	[]byte("adora"),
+	[]byte("osa"),
+	[]byte("ista"),
+	[]byte("iva"),
+	[]byte("eza"),
+	[]byte("idade"),
+	[]byte("ante"),
+	[]byte("amente"),
+	[]byte("mente"),
+	[]byte("ável"),
+	[]byte("ível"),
+	[]byte("ico"),
+	[]byte("ismo"),
+	[]byte("oso"),
+	[]byte("amento"),
+	[]byte("imento"),
+	[]byte("ivo"),
+	[]byte("aça~o"),
+	[]byte("uça~o"),
+	[]byte("ador"),
+	[]byte("icas"),
+	[]byte("ências"),
+	[]byte("logias"),
+	[]byte("iras"),
+	[]byte("adoras"),
+	[]byte("osas"),
+	[]byte("istas"),
+	[]byte("ivas"),
+	[]byte("ezas"),
+	[]byte("idades"),
+	[]byte("adores"),
+	[]byte("antes"),
+	[]byte("aço~es"),
+	[]byte("uço~es"),
+	[]byte("icos"),
+	[]byte("ismos"),
+	[]byte("osos"),
+	[]byte("amentos"),
+	[]byte("imentos"),
+	[]byte("ivos"),
+}
+
+var step2StepSuffixes = [...][]byte{
+	[]byte("ada"),
+	[]byte("ida"),
+	[]byte("aria"),
+	[]byte("eria"),
+	[]byte("iria"),
+	[]byte("ia"),
+	[]byte("ara"),
+	[]byte("era"),
+	[]byte("ira"),
+	[]byte("ava"),
+	[]byte("asse"),
+	[]byte("esse"),
+	[]byte("isse"),
+	[]byte("aste"),
+	[]byte("este"),
+	[]byte("iste"),
+	[]byte("arei"),
+	[]byte("erei"),
+	[]byte("irei"),
+	[]byte("ei"),
+	[]byte("ariam"),
+	[]byte("eriam"),
+	[]byte("iriam"),
+	[]byte("iam"),
+	[]byte("aram"),
+	[]byte("eram"),
+	[]byte("iram"),
+	[]byte("avam"),
+	[]byte("am"),
+	[]byte("arem"),
+	[]byte("erem"),
+	[]byte("irem"),
+	[]byte("assem"),
+	[]byte("essem"),
+	[]byte("issem"),
+	[]byte("em"),
+	[]byte("ado"),
+	[]byte("ido"),
+	[]byte("ando"),
+	[]byte("endo"),
+	[]byte("indo"),
+	[]byte("ara~o"),
+	[]byte("era~o"),
+	[]byte("ira~o"),
+	[]byte("ar"),
+	[]byte("er"),
+	[]byte("ir"),
+	[]byte("adas"),
+	[]byte("idas"),
+	[]byte("arias"),
+	[]byte("erias"),
+	[]byte("irias"),
+	[]byte("ias"),
+	[]byte("aras"),
+	[]byte("eras"),
+	[]byte("iras"),
+	[]byte("avas"),
+	[]byte("as"),
+	[]byte("ardes"),
+	[]byte("erdes"),
+	[]byte("irdes"),
+	[]byte("ares"),
+	[]byte("eres"),
+	[]byte("ires"),
+	[]byte("asses"),
+	[]byte("esses"),
+	[]byte("isses"),
+	[]byte("astes"),
+	[]byte("estes"),
+	[]byte("istes"),
+	[]byte("es"),
+	[]byte("ais"),
+	[]byte("areis"),
+	[]byte("ereis"),
+	[]byte("ireis"),
+	[]byte("áreis"),
+	[]byte("éreis"),
+	[]byte("íreis"),
+	[]byte("ásseis"),
+	[]byte("ésseis"),
+	[]byte("ísseis"),
+	[]byte("áveis"),
+	[]byte("aríeis"),
+	[]byte("eríeis"),
+	[]byte("iríeis"),
+	[]byte("íeis"),
+	[]byte("eis"),
+	[]byte("is"),
+	[]byte("ados"),
+	[]byte("idos"),
+	[]byte("áramos"),
+	[]byte("éramos"),
+	[]byte("íramos"),
+	[]byte("ávamos"),
+	[]byte("aríamos"),
+	[]byte("eríamos"),
+	[]byte("iríamos"),
+	[]byte("íamos"),
+	[]byte("amos"),
+	[]byte("aremos"),
+	[]byte("eremos"),
+	[]byte("iremos"),
+	[]byte("ássemos"),
+	[]byte("êssemos"),
+	[]byte("íssemos"),
+	[]byte("emos"),
+	[]byte("imos"),
+	[]byte("armos"),
+	[]byte("ermos"),
+	[]byte("irmos"),
+	[]byte("ámos"),
+	[]byte("arás"),
+	[]byte("erás"),
+	[]byte("irás"),
+	[]byte("eu"),
+	[]byte("iu"),
+	[]byte("ou"),
+	[]byte("ará"),
+	[]byte("erá"),
+	[]byte("irá"),
+}
+
+var step4StepSuffixes = [...][]byte{
+	[]byte("a"),
+	[]byte("i"),
+	[]byte("o"),
+	[]byte("os"),
+	[]byte("á"),
+	[]byte("í"),
+	[]byte("ó"),
+}
+
+var step5StepSuffixes = [...][]byte{
+	[]byte("e"),
+	[]byte("é"),
+	[]byte("ê"),
+}
+
+func step1StepNested4Nested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested4Nested0Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested4Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested4Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested4Suffixes[1]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if !bytes.HasSuffix(r2(s), step1StepNested4Suffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested4Suffixes[3]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step1StepNested4Nested0Do(r)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested5Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[0]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'v':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[1]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 0xad:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[2]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested6Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[1]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested7Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested7Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[0]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xa2:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[1]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+										case 0xaa:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[2]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "ente"...)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'g':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[3]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "log"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(rv(s), step1StepSuffixes[4]) {
+								return s, false
+							}
+							r := step1Ira(s, step1StepSuffixes[4])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'd':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[5]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[6]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[7]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[8]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested7Do(r)
+							return r, true
+						}
+					}
+				case 'z':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[9]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'd':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[10]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested6Do(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[11]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'm':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r1(s), step1StepSuffixes[12]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested4Do(r)
+													return r, true
+												}
+											}
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[13]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested5Do(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'v':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xa1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[14]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								case 0xad:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[15]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[16]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[17]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[18]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'm':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[19]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												case 'i':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[20]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[21]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested7Do(r)
+							return r, true
+						}
+					}
+				case '~':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xa7:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[22]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												case 'u':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[23]) {
+														return s, false
+													}
+													r := s[:len(s)-5]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[24]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[25]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'n':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xaa:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[26]) {
+																return s, false
+															}
+															r := append(s[:len(s)-7], "ente"...)
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								case 'g':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'o':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'l':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[27]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "log"...)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(rv(s), step1StepSuffixes[28]) {
+										return s, false
+									}
+									r := step1Ira(s, step1StepSuffixes[28])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'd':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[29]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[30]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[31]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 'v':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[32]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested7Do(r)
+									return r, true
+								}
+							}
+						case 'z':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[33]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'd':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[34]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested6Do(r)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'd':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[35]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[36]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case '~':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xa7:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[37]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															return r, true
+														case 'u':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[38]) {
+																return s, false
+															}
+															r := s[:len(s)-6]
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[39]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[40]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[41]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'm':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[42]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															return r, true
+														case 'i':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[43]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'v':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[44]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested7Do(r)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[0]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[1]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[2]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[3]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[4]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[5]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[6]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'e':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[7]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[8]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[9]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[10]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[11]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[12]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[13]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[14]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[15]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[16]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[17]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[18]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[19]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[20]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[21]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[22]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[23]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[24]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[25]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[26]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'v':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[27]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[28]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[29]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[30]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[31]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[32]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[33]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[34]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[35]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[36]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[37]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[38]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[39]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[40]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case '~':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[41]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[42]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[43]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[44]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[45]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'i':
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[46]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[47]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[48]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[49]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[50]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[51]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[52]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[53]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[54]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[55]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'v':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[56]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[57]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[58]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[59]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[60]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[61]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[62]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[63]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[64]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[65]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[66]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[67]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[68]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[69]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[70]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[71]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[72]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[73]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[74]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 0xa1:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[75]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xa9:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[76]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xad:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[77]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xa1:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[78]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												case 0xa9:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[79]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												case 0xad:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[80]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								case 'v':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xa1:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[81]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 0xad:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'r':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[82]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														case 'e':
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[83]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														case 'i':
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[84]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												}
+											}
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[85]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2StepSuffixes[86]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[87]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[88]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[89]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xa1:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[90]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												case 0xa9:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[91]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												case 0xad:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[92]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												}
+											}
+										case 'v':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xa1:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2StepSuffixes[93]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												}
+											}
+										case 0xad:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'r':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'a':
+																	if bytes.HasSuffix(rv(s), step2StepSuffixes[94]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																case 'e':
+																	if bytes.HasSuffix(rv(s), step2StepSuffixes[95]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																case 'i':
+																	if bytes.HasSuffix(rv(s), step2StepSuffixes[96]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[97]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[98]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[99]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[100]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2StepSuffixes[101]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 's':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xa1:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xc3:
+																	if bytes.HasSuffix(rv(s), step2StepSuffixes[102]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														case 0xaa:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xc3:
+																	if bytes.HasSuffix(rv(s), step2StepSuffixes[103]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														case 0xad:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xc3:
+																	if bytes.HasSuffix(rv(s), step2StepSuffixes[104]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[105]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[106]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[107]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[108]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[109]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								case 0xa1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[110]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 0xa1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[111]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[112]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2StepSuffixes[113]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'u':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[114]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'i':
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[115]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'o':
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[116]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xa1:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[117]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[118]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2StepSuffixes[119]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step4StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if !bytes.HasSuffix(rv(s), step4StepSuffixes[0]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 'i':
+			if !bytes.HasSuffix(rv(s), step4StepSuffixes[1]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 'o':
+			if !bytes.HasSuffix(rv(s), step4StepSuffixes[2]) {
+				return s, false
+			}
+			r := s[:len(s)-1]
+			return r, true
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if !bytes.HasSuffix(rv(s), step4StepSuffixes[3]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 0xa1:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(rv(s), step4StepSuffixes[4]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 0xad:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(rv(s), step4StepSuffixes[5]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 0xb3:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(rv(s), step4StepSuffixes[6]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step5StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if !bytes.HasSuffix(rv(s), step5StepSuffixes[0]) {
+				return s, false
+			}
+			r := step5E(s, step5StepSuffixes[0])
+			if r == nil {
+				return s, false
+			}
+			return r, true
+		case 0xa7:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					r := append(s[:len(s)-2], "c"...)
+					return r, true
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(rv(s), step5StepSuffixes[1]) {
+						return s, false
+					}
+					r := step5E(s, step5StepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 0xaa:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if !bytes.HasSuffix(rv(s), step5StepSuffixes[2]) {
+						return s, false
+					}
+					r := step5E(s, step5StepSuffixes[2])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
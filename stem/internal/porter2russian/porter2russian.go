@@ -0,0 +1,183 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2russian
+
+import (
+	"bytes"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/russian/stemmer.html
+
+const vowels = "аеиоуыэюя"
+
+var (
+	r2 = R2(vowels)
+	rv = RussianRV(vowels)
+)
+
+// ё and е are both two bytes in UTF-8.
+var (
+	yo = []byte("ё")
+	ye = []byte("е")
+)
+
+func normalize(s []byte) []byte {
+	for i := 0; i+1 < len(s); i++ {
+		if s[i] == yo[0] && s[i+1] == yo[1] {
+			s[i], s[i+1] = ye[0], ye[1]
+		}
+	}
+	return s
+}
+
+// afterAYa deletes suffix only if it is preceded by а or я inside RV.
+func afterAYa(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	if (bytes.HasSuffix(m, []byte("а")) || bytes.HasSuffix(m, []byte("я"))) &&
+		len(rv(s)) >= len(suffix)+len("а") {
+		return m
+	}
+	return nil
+}
+
+var perfectiveGerundStep = MustNewStep([]Suffix{
+	{Suffixes: "в вши вшись", Callback: afterAYa, MatchRegion: rv, Region: "RV"},
+	{Suffixes: "ив ивши ившись ыв ывши ывшись", Callback: Delete, MatchRegion: rv, Region: "RV"},
+})
+
+var reflexiveStep = MustNewStep([]Suffix{
+	{Suffixes: "ся сь", Callback: Delete, MatchRegion: rv, Region: "RV"},
+})
+
+var adjectivalStep = MustNewStep([]Suffix{
+	{Suffixes: "ее ие ые ое ими ыми ей ий ый ой ем им ым ом его ого ему ому их ых ую юю ая яя ою ею", Callback: Delete, MatchRegion: rv, Region: "RV",
+		// participle
+		SuffixCallbacks: []Suffix{
+			{Suffixes: "ем нн вш ющ щ", Callback: afterAYa, MatchRegion: rv, Region: "RV"},
+			{Suffixes: "ивш ывш ующ", Callback: Delete, MatchRegion: rv, Region: "RV"},
+		},
+	},
+})
+
+var verbStep = MustNewStep([]Suffix{
+	{Suffixes: "ла на ете йте ли й л ем н ло но ет ют ны ть ешь нно", Callback: afterAYa, MatchRegion: rv, Region: "RV"},
+	{Suffixes: "ила ыла ена ейте уйте ите или ыли ей уй ил ыл им ым ен ило ыло ено ят ует уют ит ыт ены ить ыть ишь ую ю", Callback: Delete, MatchRegion: rv, Region: "RV"},
+})
+
+var nounStep = MustNewStep([]Suffix{
+	{Suffixes: "а ев ов ие ье е иями ями ами еи ии и ией ей ой ий й иям ям ием ем ам ом о у ах иях ях ы ь ию ью ю ия ья я", Callback: Delete, MatchRegion: rv, Region: "RV"},
+})
+
+func step1(s []byte, t *Tracer) []byte {
+	n := len(s)
+	s = t.Hook(perfectiveGerundStep).Apply(s)
+	if len(s) != n {
+		return s
+	}
+	s = t.Hook(reflexiveStep).Apply(s)
+	n = len(s)
+	for _, st := range []*Step{adjectivalStep, verbStep, nounStep} {
+		s = t.Hook(st).Apply(s)
+		if len(s) != n {
+			break
+		}
+	}
+	return s
+}
+
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "и", Callback: Delete, MatchRegion: rv, Region: "RV"},
+})
+
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
+}
+
+var step3Step = MustNewStep([]Suffix{
+	{Suffixes: "ост ость", Callback: Delete, MatchRegion: rv, ActionRegion: r2, Region: "R2"},
+})
+
+func step3(s []byte, t *Tracer) []byte {
+	return t.Hook(step3Step).Apply(s)
+}
+
+// undoubleN removes the last н of a final нн inside RV.
+func undoubleN(s []byte) []byte {
+	if bytes.HasSuffix(s, []byte("нн")) && len(rv(s)) >= len("нн") {
+		s = s[:len(s)-len("н")]
+	}
+	return s
+}
+
+func step4Superlative(s []byte, suffix []byte) []byte {
+	return undoubleN(Delete(s, suffix))
+}
+
+func step4N(s []byte, suffix []byte) []byte {
+	return undoubleN(s)
+}
+
+var step4Step = MustNewStep([]Suffix{
+	{Suffixes: "ейш ейше", Callback: step4Superlative, MatchRegion: rv, Region: "RV"},
+	{Suffixes: "н", Callback: step4N, MatchRegion: rv, Region: "RV"},
+	{Suffixes: "ь", Callback: Delete, MatchRegion: rv, Region: "RV"},
+})
+
+func step4(s []byte, t *Tracer) []byte {
+	return t.Hook(step4Step).Apply(s)
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = normalize(s)
+	t.Record("normalize", s)
+	s = step1(s, t)
+	t.Record("step1", s)
+	s = step2(s, t)
+	t.Record("step2", s)
+	s = step3(s, t)
+	t.Record("step3", s)
+	s = step4(s, t)
+	t.Record("step4", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
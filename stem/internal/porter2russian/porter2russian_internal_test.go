@@ -0,0 +1,71 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2russian
+
+import (
+	"testing"
+
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestNormalize(t *testing.T) {
+	pairs := []string{"ёлка", "елка", "её", "ее"}
+	test(t, normalize, pairs)
+}
+
+func TestR1(t *testing.T) {
+	pairs := []string{"противоестественном", "ивоестественном", "вагон", "он", "в", ""}
+	test(t, R1(vowels), pairs)
+}
+
+func TestR2(t *testing.T) {
+	pairs := []string{"противоестественном", "оестественном", "важностию", "тию", "вагон", ""}
+	test(t, r2, pairs)
+}
+
+func TestRv(t *testing.T) {
+	pairs := []string{"противоестественном", "тивоестественном", "вагон", "гон", "в", ""}
+	test(t, rv, pairs)
+}
+
+func TestStep1(t *testing.T) {
+	pairs := []string{"прочитавши", "прочита", "валился", "вал", "важнейшими", "важнейш", "вагонов", "вагон", "читающая", "чита"}
+	test(t, untraced(step1), pairs)
+}
+
+func TestStep3(t *testing.T) {
+	pairs := []string{"противоестественность", "противоестественн", "важност", "важност"}
+	test(t, untraced(step3), pairs)
+}
+
+func TestStep4(t *testing.T) {
+	pairs := []string{"важнейш", "важн", "длинн", "длин", "вальс", "вальс", "пыль", "пыл"}
+	test(t, untraced(step4), pairs)
+}
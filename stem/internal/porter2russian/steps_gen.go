@@ -0,0 +1,2297 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2russian
+
+import (
+	"bytes"
+)
+
+func init() {
+	adjectivalStep.SetDo(adjectivalStepDo)
+	nounStep.SetDo(nounStepDo)
+	perfectiveGerundStep.SetDo(perfectiveGerundStepDo)
+	reflexiveStep.SetDo(reflexiveStepDo)
+	step2Step.SetDo(step2StepDo)
+	step3Step.SetDo(step3StepDo)
+	step4Step.SetDo(step4StepDo)
+	verbStep.SetDo(verbStepDo)
+}
+
+var adjectivalStepNested0Suffixes = [...][]byte{
+	[]byte("ывш"),
+	[]byte("ивш"),
+	[]byte("вш"),
+	[]byte("ующ"),
+	[]byte("ющ"),
+	[]byte("щ"),
+	[]byte("ем"),
+	[]byte("нн"),
+}
+
+var adjectivalStepSuffixes = [...][]byte{
+	[]byte("ему"),
+	[]byte("ому"),
+	[]byte("ых"),
+	[]byte("их"),
+	[]byte("ую"),
+	[]byte("юю"),
+	[]byte("ею"),
+	[]byte("ою"),
+	[]byte("яя"),
+	[]byte("ая"),
+	[]byte("ые"),
+	[]byte("ее"),
+	[]byte("ие"),
+	[]byte("ое"),
+	[]byte("ыми"),
+	[]byte("ими"),
+	[]byte("ый"),
+	[]byte("ей"),
+	[]byte("ий"),
+	[]byte("ой"),
+	[]byte("ым"),
+	[]byte("ем"),
+	[]byte("им"),
+	[]byte("ом"),
+	[]byte("его"),
+	[]byte("ого"),
+}
+
+var nounStepSuffixes = [...][]byte{
+	[]byte("у"),
+	[]byte("иях"),
+	[]byte("ях"),
+	[]byte("ах"),
+	[]byte("ы"),
+	[]byte("ь"),
+	[]byte("ью"),
+	[]byte("ию"),
+	[]byte("ю"),
+	[]byte("ья"),
+	[]byte("ия"),
+	[]byte("я"),
+	[]byte("а"),
+	[]byte("ев"),
+	[]byte("ов"),
+	[]byte("ье"),
+	[]byte("ие"),
+	[]byte("е"),
+	[]byte("еи"),
+	[]byte("ии"),
+	[]byte("иями"),
+	[]byte("ями"),
+	[]byte("ами"),
+	[]byte("и"),
+	[]byte("ией"),
+	[]byte("ей"),
+	[]byte("ий"),
+	[]byte("ой"),
+	[]byte("й"),
+	[]byte("иям"),
+	[]byte("ям"),
+	[]byte("ам"),
+	[]byte("ием"),
+	[]byte("ем"),
+	[]byte("ом"),
+	[]byte("о"),
+}
+
+var perfectiveGerundStepSuffixes = [...][]byte{
+	[]byte("ывшись"),
+	[]byte("ившись"),
+	[]byte("вшись"),
+	[]byte("ыв"),
+	[]byte("ив"),
+	[]byte("в"),
+	[]byte("ывши"),
+	[]byte("ивши"),
+	[]byte("вши"),
+}
+
+var reflexiveStepSuffixes = [...][]byte{
+	[]byte("сь"),
+	[]byte("ся"),
+}
+
+var step2StepSuffixes = [...][]byte{
+	[]byte("и"),
+}
+
+var step3StepSuffixes = [...][]byte{
+	[]byte("ост"),
+	[]byte("ость"),
+}
+
+var step4StepSuffixes = [...][]byte{
+	[]byte("ейш"),
+	[]byte("ь"),
+	[]byte("ейше"),
+	[]byte("н"),
+}
+
+var verbStepSuffixes = [...][]byte{
+	[]byte("ыт"),
+	[]byte("уют"),
+	[]byte("ют"),
+	[]byte("ят"),
+	[]byte("ует"),
+	[]byte("ет"),
+	[]byte("ит"),
+	[]byte("ены"),
+	[]byte("ны"),
+	[]byte("ыть"),
+	[]byte("ить"),
+	[]byte("ть"),
+	[]byte("ешь"),
+	[]byte("ишь"),
+	[]byte("ую"),
+	[]byte("ю"),
+	[]byte("ыла"),
+	[]byte("ила"),
+	[]byte("ла"),
+	[]byte("ена"),
+	[]byte("на"),
+	[]byte("ете"),
+	[]byte("ите"),
+	[]byte("уйте"),
+	[]byte("ейте"),
+	[]byte("йте"),
+	[]byte("ыли"),
+	[]byte("или"),
+	[]byte("ли"),
+	[]byte("уй"),
+	[]byte("ей"),
+	[]byte("й"),
+	[]byte("ыл"),
+	[]byte("ил"),
+	[]byte("л"),
+	[]byte("ым"),
+	[]byte("ем"),
+	[]byte("им"),
+	[]byte("ен"),
+	[]byte("н"),
+	[]byte("ыло"),
+	[]byte("ило"),
+	[]byte("ло"),
+	[]byte("ено"),
+	[]byte("нно"),
+	[]byte("но"),
+}
+
+func adjectivalStepNested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0x88:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb2:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x8b:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if bytes.HasSuffix(rv(s), adjectivalStepNested0Suffixes[0]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), adjectivalStepNested0Suffixes[1]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), adjectivalStepNested0Suffixes[2]) {
+										r := afterAYa(s, adjectivalStepNested0Suffixes[2])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x89:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8e:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x83:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if bytes.HasSuffix(rv(s), adjectivalStepNested0Suffixes[3]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), adjectivalStepNested0Suffixes[4]) {
+										r := afterAYa(s, adjectivalStepNested0Suffixes[4])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), adjectivalStepNested0Suffixes[5]) {
+						r := afterAYa(s, adjectivalStepNested0Suffixes[5])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 0xbc:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepNested0Suffixes[6]) {
+										r := afterAYa(s, adjectivalStepNested0Suffixes[6])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xbd:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xbd:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepNested0Suffixes[7]) {
+										r := afterAYa(s, adjectivalStepNested0Suffixes[7])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func adjectivalStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0x83:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xbc:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb5:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[0]) {
+														r := s[:len(s)-6]
+														r, _ = adjectivalStepNested0Do(r)
+														return r, true
+													}
+												}
+											}
+										case 0xbe:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[1]) {
+														r := s[:len(s)-6]
+														r, _ = adjectivalStepNested0Do(r)
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x85:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8b:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[2]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[3]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x8e:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x83:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[4]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0x8e:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[5]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[6]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xbe:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[7]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x8f:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8f:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[8]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xb0:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[9]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb5:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8b:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[10]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[11]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[12]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xbe:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[13]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb8:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xbc:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x8b:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[14]) {
+														r := s[:len(s)-6]
+														r, _ = adjectivalStepNested0Do(r)
+														return r, true
+													}
+												}
+											}
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[15]) {
+														r := s[:len(s)-6]
+														r, _ = adjectivalStepNested0Do(r)
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8b:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[16]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[17]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[18]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xbe:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[19]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xbc:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8b:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[20]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[21]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[22]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						case 0xbe:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[23]) {
+										r := s[:len(s)-4]
+										r, _ = adjectivalStepNested0Do(r)
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xbe:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb5:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[24]) {
+														r := s[:len(s)-6]
+														r, _ = adjectivalStepNested0Do(r)
+														return r, true
+													}
+												}
+											}
+										case 0xbe:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), adjectivalStepSuffixes[25]) {
+														r := s[:len(s)-6]
+														r, _ = adjectivalStepNested0Do(r)
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func nounStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0x83:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[0]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0x85:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8f:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), nounStepSuffixes[1]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[2]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb0:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[3]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x8b:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[4]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0x8c:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[5]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0x8e:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8c:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[6]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[7]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[8]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0x8f:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8c:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[9]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[10]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[11]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xb0:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[12]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xb2:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[13]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xbe:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[14]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb5:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8c:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[15]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[16]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[17]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xb8:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[18]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[19]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xbc:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x8f:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xb8:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xd0:
+																	if bytes.HasSuffix(rv(s), nounStepSuffixes[20]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+													if bytes.HasSuffix(rv(s), nounStepSuffixes[21]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xb0:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), nounStepSuffixes[22]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[23]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xb9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), nounStepSuffixes[24]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[25]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[26]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xbe:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[27]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[28]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xbc:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8f:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), nounStepSuffixes[29]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[30]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb0:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[31]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), nounStepSuffixes[32]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[33]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xbe:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), nounStepSuffixes[34]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xbe:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if bytes.HasSuffix(rv(s), nounStepSuffixes[35]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func perfectiveGerundStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0x8c:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x81:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0x88:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xd1:
+																	if len(s) > 8 {
+																		switch s[len(s)-9] {
+																		case 0xb2:
+																			if len(s) > 9 {
+																				switch s[len(s)-10] {
+																				case 0xd0:
+																					if len(s) > 10 {
+																						switch s[len(s)-11] {
+																						case 0x8b:
+																							if len(s) > 11 {
+																								switch s[len(s)-12] {
+																								case 0xd1:
+																									if bytes.HasSuffix(rv(s), perfectiveGerundStepSuffixes[0]) {
+																										r := s[:len(s)-12]
+																										return r, true
+																									}
+																								}
+																							}
+																						case 0xb8:
+																							if len(s) > 11 {
+																								switch s[len(s)-12] {
+																								case 0xd0:
+																									if bytes.HasSuffix(rv(s), perfectiveGerundStepSuffixes[1]) {
+																										r := s[:len(s)-12]
+																										return r, true
+																									}
+																								}
+																							}
+																						}
+																					}
+																					if bytes.HasSuffix(rv(s), perfectiveGerundStepSuffixes[2]) {
+																						r := afterAYa(s, perfectiveGerundStepSuffixes[2])
+																						if r == nil {
+																							return s, false
+																						}
+																						return r, true
+																					}
+																				}
+																			}
+																		}
+																	}
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb2:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8b:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), perfectiveGerundStepSuffixes[3]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), perfectiveGerundStepSuffixes[4]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), perfectiveGerundStepSuffixes[5]) {
+						r := afterAYa(s, perfectiveGerundStepSuffixes[5])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 0xb8:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x88:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb2:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0x8b:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xd1:
+																	if bytes.HasSuffix(rv(s), perfectiveGerundStepSuffixes[6]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														case 0xb8:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xd0:
+																	if bytes.HasSuffix(rv(s), perfectiveGerundStepSuffixes[7]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+													if bytes.HasSuffix(rv(s), perfectiveGerundStepSuffixes[8]) {
+														r := afterAYa(s, perfectiveGerundStepSuffixes[8])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func reflexiveStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0x8c:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x81:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), reflexiveStepSuffixes[0]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x8f:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x81:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), reflexiveStepSuffixes[1]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0xb8:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if bytes.HasSuffix(rv(s), step2StepSuffixes[0]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0x82:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x81:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xbe:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), step3StepSuffixes[0]) {
+														if !bytes.HasSuffix(r2(s), step3StepSuffixes[0]) {
+															return s, false
+														}
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x8c:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x82:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x81:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xbe:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xd0:
+																	if bytes.HasSuffix(rv(s), step3StepSuffixes[1]) {
+																		if !bytes.HasSuffix(r2(s), step3StepSuffixes[1]) {
+																			return s, false
+																		}
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step4StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0x88:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb5:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), step4StepSuffixes[0]) {
+														r := step4Superlative(s, step4StepSuffixes[0])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x8c:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if bytes.HasSuffix(rv(s), step4StepSuffixes[1]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xb5:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x88:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb9:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xb5:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xd0:
+																	if bytes.HasSuffix(rv(s), step4StepSuffixes[2]) {
+																		r := step4Superlative(s, step4StepSuffixes[2])
+																		if r == nil {
+																			return s, false
+																		}
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xbd:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if bytes.HasSuffix(rv(s), step4StepSuffixes[3]) {
+						r := step4N(s, step4StepSuffixes[3])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func verbStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 0x82:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8b:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[0]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0x8e:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x83:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[1]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[2]) {
+										r := afterAYa(s, verbStepSuffixes[2])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 0x8f:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[3]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x83:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[4]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[5]) {
+										r := afterAYa(s, verbStepSuffixes[5])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[6]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x8b:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xbd:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb5:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[7]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[8]) {
+										r := afterAYa(s, verbStepSuffixes[8])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x8c:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x82:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x8b:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[9]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[10]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[11]) {
+										r := afterAYa(s, verbStepSuffixes[11])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 0x88:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb5:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[12]) {
+														r := afterAYa(s, verbStepSuffixes[12])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[13]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0x8e:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x83:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[14]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), verbStepSuffixes[15]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xb0:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xbb:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x8b:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[16]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[17]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[18]) {
+										r := afterAYa(s, verbStepSuffixes[18])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 0xbd:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb5:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[19]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[20]) {
+										r := afterAYa(s, verbStepSuffixes[20])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb5:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x82:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb5:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[21]) {
+														r := afterAYa(s, verbStepSuffixes[21])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[22]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xb9:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0x83:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xd1:
+																	if bytes.HasSuffix(rv(s), verbStepSuffixes[23]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														case 0xb5:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 0xd0:
+																	if bytes.HasSuffix(rv(s), verbStepSuffixes[24]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[25]) {
+														r := afterAYa(s, verbStepSuffixes[25])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb8:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xbb:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x8b:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[26]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[27]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[28]) {
+										r := afterAYa(s, verbStepSuffixes[28])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x83:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[29]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[30]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), verbStepSuffixes[31]) {
+						r := afterAYa(s, verbStepSuffixes[31])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 0xbb:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8b:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[32]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[33]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), verbStepSuffixes[34]) {
+						r := afterAYa(s, verbStepSuffixes[34])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 0xbc:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0x8b:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd1:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[35]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[36]) {
+										r := afterAYa(s, verbStepSuffixes[36])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 0xb8:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[37]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xbd:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb5:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[38]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), verbStepSuffixes[39]) {
+						r := afterAYa(s, verbStepSuffixes[39])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 0xbe:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xd0:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xbb:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0x8b:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd1:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[40]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xb8:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[41]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[42]) {
+										r := afterAYa(s, verbStepSuffixes[42])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						case 0xbd:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xd0:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xb5:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[43]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 0xbd:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xd0:
+													if bytes.HasSuffix(rv(s), verbStepSuffixes[44]) {
+														r := afterAYa(s, verbStepSuffixes[44])
+														if r == nil {
+															return s, false
+														}
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), verbStepSuffixes[45]) {
+										r := afterAYa(s, verbStepSuffixes[45])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
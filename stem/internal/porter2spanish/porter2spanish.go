@@ -18,9 +18,12 @@ package porter2spanish
 import (
 	"bytes"
 
-	. "xojoc.pw/nlp/stem/internal/porter2"
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
 )
 
+//go:generate go run ../../porter2/porter2gen/main.go
+
 // http://snowballstem.org/algorithms/spanish/stemmer.html
 
 const vowels = "aeiouáéíóúü"
@@ -34,43 +37,70 @@ var (
 func stripAfter(after string) func(s []byte, suffix []byte) []byte {
 	return func(s []byte, suffix []byte) []byte {
 		if bytes.HasSuffix(s, []byte(after+string(suffix))) {
-			return s[:len(s)-len(suffix)+len(after)]
+			return s[:len(s)-len(suffix)]
 		}
-		return s
+		return nil
 	}
 }
 
-func step0(s []byte) []byte {
-	return s
+// step0CB deletes an attached pronoun after a gerund or an infinitive
+// in RV, removing the accent it gets, like in haciéndola.
+func step0CB(s []byte, suffix []byte) []byte {
+	m := s[:len(s)-len(suffix)]
+	v := rv(m)
+	for _, e := range [...]struct{ accented, plain string }{
+		{"iéndo", "iendo"}, {"ándo", "ando"}, {"ár", "ar"}, {"ér", "er"}, {"ír", "ir"},
+	} {
+		if bytes.HasSuffix(v, []byte(e.accented)) {
+			return append(m[:len(m)-len(e.accented)], e.plain...)
+		}
+	}
+	for _, e := range [...]string{"ando", "iendo", "ar", "er", "ir"} {
+		if bytes.HasSuffix(v, []byte(e)) {
+			return m
+		}
+	}
+	if bytes.HasSuffix(v, []byte("yendo")) && bytes.HasSuffix(m, []byte("uyendo")) {
+		return m
+	}
+	return nil
 }
 
-var step1Step = NewStep([]Suffix{
-	{"anza anzas ico ica icos icas ismo ismos able ables ible ibles ista istas oso osa osos osas amiento amientos imiento imientos", Delete, nil, r2, nil},
-	{"adora ador ación adoras adores aciones ante antes ancia ancias", Delete, nil, r2, []Suffix{{"ic", Delete, nil, r2, nil}}},
-	{"logía logías", Truncate("log"), nil, r2, nil},
-	{"ución uciones", Truncate("u"), nil, r2, nil},
-	{"encia encias", Replace("ente"), nil, r2, nil},
-	{"amente", Delete, nil, r1,
-		[]Suffix{
-			{"iv", Delete, nil, r2, []Suffix{{"at", Delete, nil, r2, nil}}},
-			{"os ic ad", Delete, nil, r2, nil},
+var step0Step = MustNewStep([]Suffix{
+	{Suffixes: "me se sela selo selas selos la le lo las les los nos", Callback: step0CB, MatchRegion: rv, Region: "RV"},
+})
+
+func step0(s []byte, t *Tracer) []byte {
+	return t.Hook(step0Step).Apply(s)
+}
+
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "anza anzas ico ica icos icas ismo ismos able ables ible ibles ista istas oso osa osos osas amiento amientos imiento imientos", Callback: Delete, ActionRegion: r2, Region: "R2"},
+	{Suffixes: "adora ador ación adoras adores aciones ante antes ancia ancias", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "ic", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+	{Suffixes: "logía logías", Callback: Truncate("log"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "ución uciones", Callback: Truncate("u"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "encia encias", Callback: Replace("ente"), ActionRegion: r2, Region: "R2"},
+	{Suffixes: "amente", Callback: Delete, ActionRegion: r1, Region: "R1",
+		SuffixCallbacks: []Suffix{
+			{Suffixes: "iv", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "at", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+			{Suffixes: "os ic ad", Callback: Delete, ActionRegion: r2, Region: "R2"},
 		},
 	},
-	{"mente", Delete, nil, r2, []Suffix{{"ante able ible", Delete, nil, r2, nil}}},
-	{"idad idades", Delete, nil, r2, []Suffix{{"abil ic iv", Delete, nil, r2, nil}}},
-	{"iva ivo ivas ivos", Delete, nil, r2, []Suffix{{"at", Delete, nil, r2, nil}}},
+	{Suffixes: "mente", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "ante able ible", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+	{Suffixes: "idad idades", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "abil ic iv", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
+	{Suffixes: "iva ivo ivas ivos", Callback: Delete, ActionRegion: r2, Region: "R2", SuffixCallbacks: []Suffix{{Suffixes: "at", Callback: Delete, ActionRegion: r2, Region: "R2"}}},
 })
 
-func step1(s []byte) []byte {
-	return step1Step.Apply(s)
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
 }
 
-var step2aStep = NewStep([]Suffix{
-	{"ya ye yan yen yeron yendo yo yó yas yes yais yamos", stripAfter("u"), rv, nil, nil},
+var step2aStep = MustNewStep([]Suffix{
+	{Suffixes: "ya ye yan yen yeron yendo yo yó yas yes yais yamos", Callback: stripAfter("u"), MatchRegion: rv, Region: "RV"},
 })
 
-func step2a(s []byte) []byte {
-	return step2aStep.Apply(s)
+func step2a(s []byte, t *Tracer) []byte {
+	return t.Hook(step2aStep).Apply(s)
 }
 
 func step2bGu(s []byte, suffix []byte) []byte {
@@ -81,59 +111,85 @@ func step2bGu(s []byte, suffix []byte) []byte {
 	return s
 }
 
-var step2bStep = NewStep([]Suffix{
-	{"en es éis emos", step2bGu, rv, nil, nil},
-	{"arían arías arán arás aríais aría aréis aríamos aremos ará aré erían erías erán erás eríais ería eréis eríamos eremos erá eré irían irías irán irás iríais iría iréis iríamos iremos irá iré aba ada ida ía ara iera ad ed id ase iese aste iste an aban ían aran ieran asen iesen aron ieron ado ido ando iendo ió ar er ir as abas adas idas ías aras ieras ases ieses ís áis abais íais arais ierais aseis ieseis asteis isteis ados idos amos ábamos íamos imos áramos iéramos iésemos ásemos", Delete, rv, nil, nil},
+var step2bStep = MustNewStep([]Suffix{
+	{Suffixes: "en es éis emos", Callback: step2bGu, MatchRegion: rv, Region: "RV"},
+	{Suffixes: "arían arías arán arás aríais aría aréis aríamos aremos ará aré erían erías erán erás eríais ería eréis eríamos eremos erá eré irían irías irán irás iríais iría iréis iríamos iremos irá iré aba ada ida ía ara iera ad ed id ase iese aste iste an aban ían aran ieran asen iesen aron ieron ado ido ando iendo ió ar er ir as abas adas idas ías aras ieras ases ieses ís áis abais íais arais ierais aseis ieseis asteis isteis ados idos amos ábamos íamos imos áramos iéramos iésemos ásemos", Callback: Delete, MatchRegion: rv, Region: "RV"},
 })
 
-func step2b(s []byte) []byte {
-	return step2bStep.Apply(s)
+func step2b(s []byte, t *Tracer) []byte {
+	return t.Hook(step2bStep).Apply(s)
 }
 
-var step3Step = NewStep([]Suffix{
-	{"os a o á í ó", Delete, rv, nil, nil},
-	{"e é", Delete, rv, nil, nil},
+// step3E deletes the suffix and the u of a preceding gu, if the u is in
+// RV.
+func step3E(s []byte, suffix []byte) []byte {
+	s = s[:len(s)-len(suffix)]
+	if bytes.HasSuffix(s, []byte("gu")) && len(rv(s)) > 0 {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+var step3Step = MustNewStep([]Suffix{
+	{Suffixes: "os a o á í ó", Callback: Delete, MatchRegion: rv, Region: "RV"},
+	{Suffixes: "e é", Callback: step3E, MatchRegion: rv, Region: "RV"},
 })
 
-func step3(s []byte) []byte {
-	return step3Step.Apply(s)
+func step3(s []byte, t *Tracer) []byte {
+	return t.Hook(step3Step).Apply(s)
 }
 
 func stripAccents(s []byte) []byte {
-	return bytes.Map(func(r rune) rune {
-		switch r {
-		case 'á':
-			return 'a'
-		case 'é':
-			return 'e'
-		case 'í':
-			return 'i'
-		case 'ó':
-			return 'o'
-		case 'ú':
-			return 'u'
-		case 'ü':
-			return 'u'
-		default:
-			return r
-		}
-	}, s)
+	return MapRunes(s, stripAccent)
+}
+
+func stripAccent(r rune) rune {
+	switch r {
+	case 'á':
+		return 'a'
+	case 'é':
+		return 'e'
+	case 'í':
+		return 'i'
+	case 'ó':
+		return 'o'
+	case 'ú':
+		return 'u'
+	default:
+		return r
+	}
 }
 
 func StemBytes(s []byte) []byte {
-	s = step0(s)
-	s1 := step1(s)
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = step0(s, t)
+	t.Record("step0", s)
+	s1 := step1(s, t)
 	if bytes.Equal(s1, s) {
-		sa := step2a(s)
+		sa := step2a(s, t)
 		if bytes.Equal(sa, s) {
-			s = step2b(s)
+			s = step2b(s, t)
+			t.Record("step2b", s)
 		} else {
 			s = sa
+			t.Record("step2a", s)
 		}
 	} else {
 		s = s1
+		t.Record("step1", s)
 	}
-	s = step3(s)
+	s = step3(s, t)
+	t.Record("step3", s)
 	for i, b := range s {
 		if b == 'I' {
 			s[i] = 'i'
@@ -142,6 +198,7 @@ func StemBytes(s []byte) []byte {
 		}
 	}
 	s = stripAccents(s)
+	t.Record("postlude", s)
 	return s
 }
 
@@ -150,7 +207,7 @@ func StemString(s string) string {
 }
 
 func NormalizeBytes(b []byte) []byte {
-	return bytes.ToLower(bytes.TrimSpace(b))
+	return textnorm.Bytes(b)
 }
 
 func NormalizeString(s string) string {
@@ -15,7 +15,11 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>. */
 
 package porter2spanish
 
-import "testing"
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
 
 func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
 	for i := 0; i < len(pairs); i += 2 {
@@ -27,6 +31,10 @@ func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
 
 }
 
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
 func TestR1(t *testing.T) {
 	pairs := []string{"beautiful", "iful", "beauty", "y", "beau", "", "animadversion", "imadversion", "sprinkled", "kled", "eucharist", "harist"}
 	test(t, r1, pairs)
@@ -44,5 +52,5 @@ func TestRv(t *testing.T) {
 
 func TestStep0(t *testing.T) {
 	pairs := []string{"haciéndola", "haciendo"}
-	test(t, step0, pairs)
+	test(t, untraced(step0), pairs)
 }
@@ -22,8 +22,8 @@ import (
 	"strings"
 	"testing"
 
-	"gitlab.com/gonlp/stem/porter2spanish"
 	"gitlab.com/xojoc/util"
+	"xojoc.pw/nlp/stem/internal/porter2spanish"
 )
 
 func TestStemBytes(t *testing.T) {
@@ -52,11 +52,14 @@ func loadWords() {
 	if words != nil {
 		return
 	}
-	f := util.MustOpen("vocabulary.txt")
+	f := util.MustOpen("testfiles/vocabulary.txt")
 	defer f.Close()
 	scanner := bufio.NewScanner(f)
 	for scanner.Scan() {
 		ws := bytes.Fields(scanner.Bytes())
+		if len(ws) == 0 {
+			continue
+		}
 		words = append(words, ws[0])
 	}
 	util.Fatal(scanner.Err())
@@ -65,6 +68,7 @@ func loadWords() {
 
 func BenchmarkStemBytes(b *testing.B) {
 	loadWords()
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		for _, w := range words {
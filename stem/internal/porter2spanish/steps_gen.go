@@ -0,0 +1,2657 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2spanish
+
+import (
+	"bytes"
+)
+
+func init() {
+	step0Step.SetDo(step0StepDo)
+	step1Step.SetDo(step1StepDo)
+	step2aStep.SetDo(step2aStepDo)
+	step2bStep.SetDo(step2bStepDo)
+	step3Step.SetDo(step3StepDo)
+}
+
+var step0StepSuffixes = [...][]byte{
+	[]byte("sela"),
+	[]byte("la"),
+	[]byte("le"),
+	[]byte("me"),
+	[]byte("se"),
+	[]byte("selo"),
+	[]byte("lo"),
+	[]byte("selas"),
+	[]byte("las"),
+	[]byte("les"),
+	[]byte("selos"),
+	[]byte("los"),
+	[]byte("nos"),
+}
+
+var step1StepNested1Suffixes = [...][]byte{
+	[]byte("ic"),
+}
+
+var step1StepNested5Nested0Suffixes = [...][]byte{
+	[]byte("at"),
+}
+
+var step1StepNested5Suffixes = [...][]byte{
+	[]byte("ic"),
+	[]byte("ad"),
+	[]byte("os"),
+	[]byte("iv"),
+}
+
+var step1StepNested6Suffixes = [...][]byte{
+	[]byte("able"),
+	[]byte("ible"),
+	[]byte("ante"),
+}
+
+var step1StepNested7Suffixes = [...][]byte{
+	[]byte("ic"),
+	[]byte("abil"),
+	[]byte("iv"),
+}
+
+var step1StepNested8Suffixes = [...][]byte{
+	[]byte("at"),
+}
+
+var step1StepSuffixes = [...][]byte{
+	[]byte("ica"),
+	[]byte("ancia"),
+	[]byte("encia"),
+	[]byte("adora"),
+	[]byte("osa"),
+	[]byte("ista"),
+	[]byte("iva"),
+	[]byte("anza"),
+	[]byte("logía"),
+	[]byte("idad"),
+	[]byte("able"),
+	[]byte("ible"),
+	[]byte("ante"),
+	[]byte("amente"),
+	[]byte("mente"),
+	[]byte("ación"),
+	[]byte("ución"),
+	[]byte("ico"),
+	[]byte("ismo"),
+	[]byte("oso"),
+	[]byte("amiento"),
+	[]byte("imiento"),
+	[]byte("ivo"),
+	[]byte("ador"),
+	[]byte("icas"),
+	[]byte("ancias"),
+	[]byte("encias"),
+	[]byte("adoras"),
+	[]byte("osas"),
+	[]byte("istas"),
+	[]byte("ivas"),
+	[]byte("anzas"),
+	[]byte("logías"),
+	[]byte("idades"),
+	[]byte("ables"),
+	[]byte("ibles"),
+	[]byte("aciones"),
+	[]byte("uciones"),
+	[]byte("adores"),
+	[]byte("antes"),
+	[]byte("icos"),
+	[]byte("ismos"),
+	[]byte("osos"),
+	[]byte("amientos"),
+	[]byte("imientos"),
+	[]byte("ivos"),
+}
+
+var step2aStepCallbacks = [...]func([]byte, []byte) []byte{
+	stripAfter("u"),
+}
+
+var step2aStepSuffixes = [...][]byte{
+	[]byte("ya"),
+	[]byte("ye"),
+	[]byte("yan"),
+	[]byte("yen"),
+	[]byte("yeron"),
+	[]byte("yendo"),
+	[]byte("yo"),
+	[]byte("yas"),
+	[]byte("yes"),
+	[]byte("yais"),
+	[]byte("yamos"),
+	[]byte("yó"),
+}
+
+var step2bStepSuffixes = [...][]byte{
+	[]byte("aba"),
+	[]byte("ada"),
+	[]byte("ida"),
+	[]byte("ara"),
+	[]byte("iera"),
+	[]byte("aría"),
+	[]byte("ería"),
+	[]byte("iría"),
+	[]byte("ía"),
+	[]byte("ad"),
+	[]byte("ed"),
+	[]byte("id"),
+	[]byte("ase"),
+	[]byte("iese"),
+	[]byte("aste"),
+	[]byte("iste"),
+	[]byte("aban"),
+	[]byte("aran"),
+	[]byte("ieran"),
+	[]byte("arían"),
+	[]byte("erían"),
+	[]byte("irían"),
+	[]byte("ían"),
+	[]byte("an"),
+	[]byte("asen"),
+	[]byte("iesen"),
+	[]byte("en"),
+	[]byte("aron"),
+	[]byte("ieron"),
+	[]byte("arán"),
+	[]byte("erán"),
+	[]byte("irán"),
+	[]byte("ado"),
+	[]byte("ido"),
+	[]byte("ando"),
+	[]byte("iendo"),
+	[]byte("ar"),
+	[]byte("er"),
+	[]byte("ir"),
+	[]byte("abas"),
+	[]byte("adas"),
+	[]byte("idas"),
+	[]byte("aras"),
+	[]byte("ieras"),
+	[]byte("arías"),
+	[]byte("erías"),
+	[]byte("irías"),
+	[]byte("ías"),
+	[]byte("as"),
+	[]byte("ases"),
+	[]byte("ieses"),
+	[]byte("es"),
+	[]byte("abais"),
+	[]byte("arais"),
+	[]byte("ierais"),
+	[]byte("aríais"),
+	[]byte("eríais"),
+	[]byte("iríais"),
+	[]byte("íais"),
+	[]byte("aseis"),
+	[]byte("ieseis"),
+	[]byte("asteis"),
+	[]byte("isteis"),
+	[]byte("áis"),
+	[]byte("aréis"),
+	[]byte("eréis"),
+	[]byte("iréis"),
+	[]byte("éis"),
+	[]byte("ados"),
+	[]byte("idos"),
+	[]byte("ábamos"),
+	[]byte("áramos"),
+	[]byte("iéramos"),
+	[]byte("aríamos"),
+	[]byte("eríamos"),
+	[]byte("iríamos"),
+	[]byte("íamos"),
+	[]byte("amos"),
+	[]byte("aremos"),
+	[]byte("eremos"),
+	[]byte("iremos"),
+	[]byte("ásemos"),
+	[]byte("iésemos"),
+	[]byte("emos"),
+	[]byte("imos"),
+	[]byte("arás"),
+	[]byte("erás"),
+	[]byte("irás"),
+	[]byte("ís"),
+	[]byte("ará"),
+	[]byte("erá"),
+	[]byte("irá"),
+	[]byte("aré"),
+	[]byte("eré"),
+	[]byte("iré"),
+	[]byte("ió"),
+}
+
+var step3StepSuffixes = [...][]byte{
+	[]byte("a"),
+	[]byte("e"),
+	[]byte("o"),
+	[]byte("os"),
+	[]byte("á"),
+	[]byte("é"),
+	[]byte("í"),
+	[]byte("ó"),
+}
+
+func step0StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[0]) {
+										r := step0CB(s, step0StepSuffixes[0])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[1]) {
+						r := step0CB(s, step0StepSuffixes[1])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[2]) {
+						r := step0CB(s, step0StepSuffixes[2])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'm':
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[3]) {
+						r := step0CB(s, step0StepSuffixes[3])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 's':
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[4]) {
+						r := step0CB(s, step0StepSuffixes[4])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if bytes.HasSuffix(rv(s), step0StepSuffixes[5]) {
+										r := step0CB(s, step0StepSuffixes[5])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step0StepSuffixes[6]) {
+						r := step0CB(s, step0StepSuffixes[6])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if bytes.HasSuffix(rv(s), step0StepSuffixes[7]) {
+												r := step0CB(s, step0StepSuffixes[7])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step0StepSuffixes[8]) {
+								r := step0CB(s, step0StepSuffixes[8])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if bytes.HasSuffix(rv(s), step0StepSuffixes[9]) {
+								r := step0CB(s, step0StepSuffixes[9])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if bytes.HasSuffix(rv(s), step0StepSuffixes[10]) {
+												r := step0CB(s, step0StepSuffixes[10])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step0StepSuffixes[11]) {
+								r := step0CB(s, step0StepSuffixes[11])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						case 'n':
+							if bytes.HasSuffix(rv(s), step0StepSuffixes[12]) {
+								r := step0CB(s, step0StepSuffixes[12])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested1Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested1Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested5Nested0Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested5Nested0Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested5Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[1]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested5Suffixes[3]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					r, _ = step1StepNested5Nested0Do(r)
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested6Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[0]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[1]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepNested6Suffixes[2]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested7Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested7Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepNested7Suffixes[1]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'v':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if !bytes.HasSuffix(r2(s), step1StepNested7Suffixes[2]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepNested8Do(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if !bytes.HasSuffix(r2(s), step1StepNested8Suffixes[0]) {
+						return s, false
+					}
+					r := s[:len(s)-2]
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step1StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[0]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[1]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested1Do(r)
+											return r, true
+										case 'e':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[2]) {
+												return s, false
+											}
+											r := append(s[:len(s)-5], "ente"...)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'd':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[3]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested1Do(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[4]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[5]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[6]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested8Do(r)
+							return r, true
+						}
+					}
+				case 'z':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[7]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 0xad:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'g':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'o':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'l':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[8]) {
+														return s, false
+													}
+													r := s[:len(s)-3]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[9]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested7Do(r)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[10]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[11]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[12]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested1Do(r)
+									return r, true
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'm':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r1(s), step1StepSuffixes[13]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested5Do(r)
+													return r, true
+												}
+											}
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[14]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested6Do(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xb3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'c':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[15]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested1Do(r)
+													return r, true
+												case 'u':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[16]) {
+														return s, false
+													}
+													r := s[:len(s)-5]
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[17]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 'm':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[18]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[19]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							return r, true
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'm':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[20]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															return r, true
+														case 'i':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[21]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if !bytes.HasSuffix(r2(s), step1StepSuffixes[22]) {
+								return s, false
+							}
+							r := s[:len(s)-3]
+							r, _ = step1StepNested8Do(r)
+							return r, true
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[23]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested1Do(r)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[24]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'n':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[25]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested1Do(r)
+													return r, true
+												case 'e':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[26]) {
+														return s, false
+													}
+													r := append(s[:len(s)-6], "ente"...)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'd':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[27]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested1Do(r)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[28]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[29]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 'v':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[30]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested8Do(r)
+									return r, true
+								}
+							}
+						case 'z':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[31]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 0xad:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'g':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'o':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'l':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[32]) {
+																return s, false
+															}
+															r := s[:len(s)-4]
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'd':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[33]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested7Do(r)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'l':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[34]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[35]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'c':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[36]) {
+																return s, false
+															}
+															r := s[:len(s)-7]
+															r, _ = step1StepNested1Do(r)
+															return r, true
+														case 'u':
+															if !bytes.HasSuffix(r2(s), step1StepSuffixes[37]) {
+																return s, false
+															}
+															r := s[:len(s)-6]
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'd':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if !bytes.HasSuffix(r2(s), step1StepSuffixes[38]) {
+														return s, false
+													}
+													r := s[:len(s)-6]
+													r, _ = step1StepNested1Do(r)
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[39]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											r, _ = step1StepNested1Do(r)
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[40]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if !bytes.HasSuffix(r2(s), step1StepSuffixes[41]) {
+												return s, false
+											}
+											r := s[:len(s)-5]
+											return r, true
+										}
+									}
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[42]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									return r, true
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'm':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'a':
+																	if !bytes.HasSuffix(r2(s), step1StepSuffixes[43]) {
+																		return s, false
+																	}
+																	r := s[:len(s)-8]
+																	return r, true
+																case 'i':
+																	if !bytes.HasSuffix(r2(s), step1StepSuffixes[44]) {
+																		return s, false
+																	}
+																	r := s[:len(s)-8]
+																	return r, true
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 'v':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if !bytes.HasSuffix(r2(s), step1StepSuffixes[45]) {
+										return s, false
+									}
+									r := s[:len(s)-4]
+									r, _ = step1StepNested8Do(r)
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2aStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'y':
+					if bytes.HasSuffix(rv(s), step2aStepSuffixes[0]) {
+						r := step2aStepCallbacks[0](s, step2aStepSuffixes[0])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'y':
+					if bytes.HasSuffix(rv(s), step2aStepSuffixes[1]) {
+						r := step2aStepCallbacks[0](s, step2aStepSuffixes[1])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'y':
+							if bytes.HasSuffix(rv(s), step2aStepSuffixes[2]) {
+								r := step2aStepCallbacks[0](s, step2aStepSuffixes[2])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'y':
+							if bytes.HasSuffix(rv(s), step2aStepSuffixes[3]) {
+								r := step2aStepCallbacks[0](s, step2aStepSuffixes[3])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'y':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[4]) {
+												r := step2aStepCallbacks[0](s, step2aStepSuffixes[4])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'y':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[5]) {
+												r := step2aStepCallbacks[0](s, step2aStepSuffixes[5])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'y':
+					if bytes.HasSuffix(rv(s), step2aStepSuffixes[6]) {
+						r := step2aStepCallbacks[0](s, step2aStepSuffixes[6])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'y':
+							if bytes.HasSuffix(rv(s), step2aStepSuffixes[7]) {
+								r := step2aStepCallbacks[0](s, step2aStepSuffixes[7])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'y':
+							if bytes.HasSuffix(rv(s), step2aStepSuffixes[8]) {
+								r := step2aStepCallbacks[0](s, step2aStepSuffixes[8])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'y':
+									if bytes.HasSuffix(rv(s), step2aStepSuffixes[9]) {
+										r := step2aStepCallbacks[0](s, step2aStepSuffixes[9])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'y':
+											if bytes.HasSuffix(rv(s), step2aStepSuffixes[10]) {
+												r := step2aStepCallbacks[0](s, step2aStepSuffixes[10])
+												if r == nil {
+													return s, false
+												}
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb3:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'y':
+							if bytes.HasSuffix(rv(s), step2aStepSuffixes[11]) {
+								r := step2aStepCallbacks[0](s, step2aStepSuffixes[11])
+								if r == nil {
+									return s, false
+								}
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2bStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'b':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[0]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[1]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[2]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[3]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[4]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 0xad:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[5]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[6]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[7]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[8]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[9]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[10]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'i':
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[11]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[12]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[13]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[14]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[15]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[16]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[17]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[18]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 0xad:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[19]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[20]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[21]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[22]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[23]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[24]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[25]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[26]) {
+						r := step2bGu(s, step2bStepSuffixes[26])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[27]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[28]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 0xa1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[29]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[30]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[31]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'o':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[32]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[33]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[34]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[35]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[36]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[37]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'i':
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[38]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[39]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[40]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[41]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[42]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[43]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 0xad:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[44]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[45]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[46]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[47]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[48]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[49]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[50]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(rv(s), step2bStepSuffixes[51]) {
+						r := step2bGu(s, step2bStepSuffixes[51])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'b':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[52]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[53]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[54]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 0xad:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 0xc3:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'r':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															if bytes.HasSuffix(rv(s), step2bStepSuffixes[55]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														case 'e':
+															if bytes.HasSuffix(rv(s), step2bStepSuffixes[56]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														case 'i':
+															if bytes.HasSuffix(rv(s), step2bStepSuffixes[57]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												}
+											}
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[58]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[59]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[60]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[61]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[62]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						case 0xa1:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[63]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 0xa9:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[64]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[65]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[66]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[67]) {
+										r := step2bGu(s, step2bStepSuffixes[67])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[68]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[69]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 'm':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'b':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xa1:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2bStepSuffixes[70]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												}
+											}
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xa1:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2bStepSuffixes[71]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												case 0xa9:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'i':
+																	if bytes.HasSuffix(rv(s), step2bStepSuffixes[72]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+												}
+											}
+										case 0xad:
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xc3:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'r':
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'a':
+																	if bytes.HasSuffix(rv(s), step2bStepSuffixes[73]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																case 'e':
+																	if bytes.HasSuffix(rv(s), step2bStepSuffixes[74]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																case 'i':
+																	if bytes.HasSuffix(rv(s), step2bStepSuffixes[75]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[76]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[77]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'r':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'a':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[78]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'e':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[79]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												case 'i':
+													if bytes.HasSuffix(rv(s), step2bStepSuffixes[80]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 0xa1:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if bytes.HasSuffix(rv(s), step2bStepSuffixes[81]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												case 0xa9:
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 0xc3:
+															if len(s) > 7 {
+																switch s[len(s)-8] {
+																case 'i':
+																	if bytes.HasSuffix(rv(s), step2bStepSuffixes[82]) {
+																		r := s[:len(s)-8]
+																		return r, true
+																	}
+																}
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[83]) {
+										r := step2bGu(s, step2bStepSuffixes[83])
+										if r == nil {
+											return s, false
+										}
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[84]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 0xa1:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[85]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[86]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'i':
+											if bytes.HasSuffix(rv(s), step2bStepSuffixes[87]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 0xad:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xc3:
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[88]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 0xa1:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[89]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[90]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[91]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[92]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[93]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'i':
+									if bytes.HasSuffix(rv(s), step2bStepSuffixes[94]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 0xb3:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if bytes.HasSuffix(rv(s), step2bStepSuffixes[95]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if bytes.HasSuffix(rv(s), step3StepSuffixes[0]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		case 'e':
+			if bytes.HasSuffix(rv(s), step3StepSuffixes[1]) {
+				r := step3E(s, step3StepSuffixes[1])
+				if r == nil {
+					return s, false
+				}
+				return r, true
+			}
+		case 'o':
+			if bytes.HasSuffix(rv(s), step3StepSuffixes[2]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if bytes.HasSuffix(rv(s), step3StepSuffixes[3]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xa1:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if bytes.HasSuffix(rv(s), step3StepSuffixes[4]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xa9:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if bytes.HasSuffix(rv(s), step3StepSuffixes[5]) {
+						r := step3E(s, step3StepSuffixes[5])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 0xad:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if bytes.HasSuffix(rv(s), step3StepSuffixes[6]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 0xb3:
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 0xc3:
+					if bytes.HasSuffix(rv(s), step3StepSuffixes[7]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
@@ -0,0 +1,103 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2swedish
+
+import (
+	"strings"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// http://snowballstem.org/algorithms/swedish/stemmer.html
+
+const vowels = "aeiouyäåö"
+
+var r1 = ScandinavianR1(vowels)
+
+func step1S(s []byte, suffix []byte) []byte {
+	const validSEnding = "bcdfghjklmnoprtvy"
+	if len(s) > len(suffix) && strings.IndexByte(validSEnding, s[len(s)-len(suffix)-1]) >= 0 {
+		return Delete(s, suffix)
+	}
+	return nil
+}
+
+var step1Step = MustNewStep([]Suffix{
+	{Suffixes: "a arna erna heterna orna ad e ade ande arne are aste en anden aren heten ern ar er heter or as arnas ernas ornas es ades andes ens arens hetens erns at andet het ast", Callback: Delete, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "s", Callback: step1S, MatchRegion: r1, Region: "R1"},
+})
+
+func step1(s []byte, t *Tracer) []byte {
+	return t.Hook(step1Step).Apply(s)
+}
+
+func undouble(s []byte, suffix []byte) []byte {
+	return s[:len(s)-1]
+}
+
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "dd gd nn dt gt kt tt", Callback: undouble, MatchRegion: r1, Region: "R1"},
+})
+
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
+}
+
+var step3Step = MustNewStep([]Suffix{
+	{Suffixes: "lig ig els", Callback: Delete, MatchRegion: r1, Region: "R1"},
+	{Suffixes: "löst", Callback: Truncate("lös"), MatchRegion: r1, Region: "R1"},
+	{Suffixes: "fullt", Callback: Truncate("full"), MatchRegion: r1, Region: "R1"},
+})
+
+func step3(s []byte, t *Tracer) []byte {
+	return t.Hook(step3Step).Apply(s)
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	s = step1(s, t)
+	t.Record("step1", s)
+	s = step2(s, t)
+	t.Record("step2", s)
+	s = step3(s, t)
+	t.Record("step3", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
@@ -0,0 +1,649 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porter2swedish
+
+import (
+	"bytes"
+)
+
+func init() {
+	step1Step.SetDo(step1StepDo)
+	step2Step.SetDo(step2StepDo)
+	step3Step.SetDo(step3StepDo)
+}
+
+var step1StepSuffixes = [...][]byte{
+	[]byte("arna"),
+	[]byte("heterna"),
+	[]byte("erna"),
+	[]byte("orna"),
+	[]byte("a"),
+	[]byte("ad"),
+	[]byte("ade"),
+	[]byte("ande"),
+	[]byte("arne"),
+	[]byte("are"),
+	[]byte("aste"),
+	[]byte("e"),
+	[]byte("anden"),
+	[]byte("aren"),
+	[]byte("heten"),
+	[]byte("en"),
+	[]byte("ern"),
+	[]byte("ar"),
+	[]byte("heter"),
+	[]byte("er"),
+	[]byte("or"),
+	[]byte("arnas"),
+	[]byte("ernas"),
+	[]byte("ornas"),
+	[]byte("as"),
+	[]byte("ades"),
+	[]byte("andes"),
+	[]byte("es"),
+	[]byte("arens"),
+	[]byte("hetens"),
+	[]byte("ens"),
+	[]byte("erns"),
+	[]byte("s"),
+	[]byte("at"),
+	[]byte("andet"),
+	[]byte("het"),
+	[]byte("ast"),
+}
+
+var step2StepSuffixes = [...][]byte{
+	[]byte("dd"),
+	[]byte("gd"),
+	[]byte("nn"),
+	[]byte("dt"),
+	[]byte("gt"),
+	[]byte("kt"),
+	[]byte("tt"),
+}
+
+var step3StepSuffixes = [...][]byte{
+	[]byte("lig"),
+	[]byte("ig"),
+	[]byte("els"),
+	[]byte("fullt"),
+	[]byte("löst"),
+}
+
+func step1StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'a':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[0]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 't':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'e':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'h':
+															if bytes.HasSuffix(r1(s), step1StepSuffixes[1]) {
+																r := s[:len(s)-7]
+																return r, true
+															}
+														}
+													}
+												}
+											}
+										}
+									}
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[2]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'o':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[3]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+			if bytes.HasSuffix(r1(s), step1StepSuffixes[4]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[5]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[6]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[7]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[8]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[9]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[10]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+			if bytes.HasSuffix(r1(s), step1StepSuffixes[11]) {
+				r := s[:len(s)-1]
+				return r, true
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[12]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[13]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'h':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[14]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[15]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'r':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[16]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[17]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'h':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[18]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[19]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'o':
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[20]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[21]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'e':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[22]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										case 'o':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[23]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[24]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[25]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[26]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[27]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'r':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[28]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'h':
+													if bytes.HasSuffix(r1(s), step1StepSuffixes[29]) {
+														r := s[:len(s)-6]
+														return r, true
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[30]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						case 'r':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'e':
+									if bytes.HasSuffix(r1(s), step1StepSuffixes[31]) {
+										r := s[:len(s)-4]
+										return r, true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+			if bytes.HasSuffix(r1(s), step1StepSuffixes[32]) {
+				r := step1S(s, step1StepSuffixes[32])
+				if r == nil {
+					return s, false
+				}
+				return r, true
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if bytes.HasSuffix(r1(s), step1StepSuffixes[33]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'd':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if bytes.HasSuffix(r1(s), step1StepSuffixes[34]) {
+												r := s[:len(s)-5]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						case 'h':
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[35]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if bytes.HasSuffix(r1(s), step1StepSuffixes[36]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[0]) {
+						r := undouble(s, step2StepSuffixes[0])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'g':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[1]) {
+						r := undouble(s, step2StepSuffixes[1])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[2]) {
+						r := undouble(s, step2StepSuffixes[2])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'd':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[3]) {
+						r := undouble(s, step2StepSuffixes[3])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'g':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[4]) {
+						r := undouble(s, step2StepSuffixes[4])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 'k':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[5]) {
+						r := undouble(s, step2StepSuffixes[5])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				case 't':
+					if bytes.HasSuffix(r1(s), step2StepSuffixes[6]) {
+						r := undouble(s, step2StepSuffixes[6])
+						if r == nil {
+							return s, false
+						}
+						return r, true
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if bytes.HasSuffix(r1(s), step3StepSuffixes[0]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+					if bytes.HasSuffix(r1(s), step3StepSuffixes[1]) {
+						r := s[:len(s)-2]
+						return r, true
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if bytes.HasSuffix(r1(s), step3StepSuffixes[2]) {
+								r := s[:len(s)-3]
+								return r, true
+							}
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'l':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'u':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'f':
+											if bytes.HasSuffix(r1(s), step3StepSuffixes[3]) {
+												r := s[:len(s)-1]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 0xb6:
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 0xc3:
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'l':
+											if bytes.HasSuffix(r1(s), step3StepSuffixes[4]) {
+												r := s[:len(s)-1]
+												return r, true
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
@@ -0,0 +1,257 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porterenglish
+
+import (
+	"bytes"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+//go:generate go run ../../porter2/porter2gen/main.go
+
+// https://tartarus.org/martin/PorterStemmer/def.txt
+//
+// This follows Martin Porter's reference implementation, which departs
+// from the published algorithm in step 2, where abli is replaced by bli
+// and logi is added, and leaves words of one or two letters alone.
+
+// cons reports whether s[i] is a consonant. y is a consonant at the
+// beginning of a word or after a vowel.
+func cons(s []byte, i int) bool {
+	switch s[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !cons(s, i-1)
+	}
+	return true
+}
+
+// m returns the measure of s, that is the number of vowel sequences
+// followed by a consonant sequence.
+func m(s []byte) int {
+	n := 0
+	i := 0
+	for i < len(s) && cons(s, i) {
+		i++
+	}
+	for {
+		for i < len(s) && !cons(s, i) {
+			i++
+		}
+		if i == len(s) {
+			return n
+		}
+		for i < len(s) && cons(s, i) {
+			i++
+		}
+		n++
+	}
+}
+
+func vowelInStem(s []byte) bool {
+	for i := range s {
+		if !cons(s, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func doubleC(s []byte) bool {
+	n := len(s)
+	return n >= 2 && s[n-1] == s[n-2] && cons(s, n-1)
+}
+
+// cvc reports whether s ends consonant-vowel-consonant and the last
+// consonant is not w, x or y.
+func cvc(s []byte) bool {
+	n := len(s)
+	if n < 3 || !cons(s, n-3) || cons(s, n-2) || !cons(s, n-1) {
+		return false
+	}
+	switch s[n-1] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+// mGreater returns a callback which calls cb only if the measure of the
+// stem left by suffix is greater than n.
+func mGreater(n int, cb func([]byte, []byte) []byte) func([]byte, []byte) []byte {
+	return func(s []byte, suffix []byte) []byte {
+		if m(s[:len(s)-len(suffix)]) > n {
+			return cb(s, suffix)
+		}
+		return nil
+	}
+}
+
+var step1aStep = MustNewStep([]Suffix{
+	{Suffixes: "sses", Callback: Truncate("ss")},
+	{Suffixes: "ies", Callback: Truncate("i")},
+	{Suffixes: "ss", Callback: Truncate("ss")},
+	{Suffixes: "s", Callback: Delete},
+})
+
+func step1a(s []byte, t *Tracer) []byte {
+	return t.Hook(step1aStep).Apply(s)
+}
+
+func step1bDelete(s []byte, suffix []byte) []byte {
+	s = s[:len(s)-len(suffix)]
+	if !vowelInStem(s) {
+		return nil
+	}
+	switch {
+	case bytes.HasSuffix(s, []byte("at")) || bytes.HasSuffix(s, []byte("bl")) || bytes.HasSuffix(s, []byte("iz")):
+		s = append(s, 'e')
+	case doubleC(s):
+		switch s[len(s)-1] {
+		case 'l', 's', 'z':
+		default:
+			s = s[:len(s)-1]
+		}
+	case m(s) == 1 && cvc(s):
+		s = append(s, 'e')
+	}
+	return s
+}
+
+var step1bStep = MustNewStep([]Suffix{
+	{Suffixes: "eed", Callback: mGreater(0, Truncate("ee"))},
+	{Suffixes: "ed ing", Callback: step1bDelete},
+})
+
+func step1b(s []byte, t *Tracer) []byte {
+	return t.Hook(step1bStep).Apply(s)
+}
+
+func step1c(s []byte) []byte {
+	if n := len(s); n > 0 && s[n-1] == 'y' && vowelInStem(s[:n-1]) {
+		s[n-1] = 'i'
+	}
+	return s
+}
+
+var step2Step = MustNewStep([]Suffix{
+	{Suffixes: "ational ation ator", Callback: mGreater(0, Replace("ate"))},
+	{Suffixes: "tional", Callback: mGreater(0, Truncate("tion"))},
+	{Suffixes: "enci", Callback: mGreater(0, Replace("ence"))},
+	{Suffixes: "anci", Callback: mGreater(0, Replace("ance"))},
+	{Suffixes: "izer ization", Callback: mGreater(0, Replace("ize"))},
+	{Suffixes: "bli biliti", Callback: mGreater(0, Replace("ble"))},
+	{Suffixes: "alli alism aliti", Callback: mGreater(0, Truncate("al"))},
+	{Suffixes: "entli", Callback: mGreater(0, Truncate("ent"))},
+	{Suffixes: "eli", Callback: mGreater(0, Truncate("e"))},
+	{Suffixes: "ousli ousness", Callback: mGreater(0, Truncate("ous"))},
+	{Suffixes: "iveness iviti", Callback: mGreater(0, Replace("ive"))},
+	{Suffixes: "fulness", Callback: mGreater(0, Truncate("ful"))},
+	{Suffixes: "logi", Callback: mGreater(0, Truncate("log"))},
+})
+
+func step2(s []byte, t *Tracer) []byte {
+	return t.Hook(step2Step).Apply(s)
+}
+
+var step3Step = MustNewStep([]Suffix{
+	{Suffixes: "icate iciti ical", Callback: mGreater(0, Truncate("ic"))},
+	{Suffixes: "ative ful ness", Callback: mGreater(0, Delete)},
+	{Suffixes: "alize", Callback: mGreater(0, Truncate("al"))},
+})
+
+func step3(s []byte, t *Tracer) []byte {
+	return t.Hook(step3Step).Apply(s)
+}
+
+func step4Ion(s []byte, suffix []byte) []byte {
+	s = s[:len(s)-len(suffix)]
+	if n := len(s); n > 0 && (s[n-1] == 's' || s[n-1] == 't') && m(s) > 1 {
+		return s
+	}
+	return nil
+}
+
+var step4Step = MustNewStep([]Suffix{
+	{Suffixes: "al ance ence er ic able ible ant ement ment ent ou ism ate iti ous ive ize", Callback: mGreater(1, Delete)},
+	{Suffixes: "ion", Callback: step4Ion},
+})
+
+func step4(s []byte, t *Tracer) []byte {
+	return t.Hook(step4Step).Apply(s)
+}
+
+func step5(s []byte) []byte {
+	if n := len(s); n > 0 && s[n-1] == 'e' {
+		if a := m(s[:n-1]); a > 1 || a == 1 && !cvc(s[:n-1]) {
+			s = s[:n-1]
+		}
+	}
+	if n := len(s); n > 0 && s[n-1] == 'l' && doubleC(s) && m(s) > 1 {
+		s = s[:n-1]
+	}
+	return s
+}
+
+func StemBytes(s []byte) []byte {
+	return stemBytes(s, nil)
+}
+
+// Explain stems s and returns the steps that changed it.
+func Explain(s []byte) []Trace {
+	t := NewTracer(s)
+	stemBytes(s, t)
+	return t.Traces
+}
+
+func stemBytes(s []byte, t *Tracer) []byte {
+	if len(s) <= 2 {
+		return s
+	}
+	s = step1a(s, t)
+	t.Record("step1a", s)
+	s = step1b(s, t)
+	t.Record("step1b", s)
+	if len(s) <= 1 {
+		return s
+	}
+	s = step1c(s)
+	t.Record("step1c", s)
+	s = step2(s, t)
+	t.Record("step2", s)
+	s = step3(s, t)
+	t.Record("step3", s)
+	s = step4(s, t)
+	t.Record("step4", s)
+	s = step5(s)
+	t.Record("step5", s)
+	return s
+}
+
+func StemString(s string) string {
+	return string(StemBytes([]byte(s)))
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
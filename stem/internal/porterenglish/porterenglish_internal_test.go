@@ -0,0 +1,97 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porterenglish
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func test(t *testing.T, fn func([]byte) []byte, pairs []string) {
+	for i := 0; i < len(pairs); i += 2 {
+		actual := string(fn([]byte(pairs[i])))
+		if actual != pairs[i+1] {
+			t.Errorf("fn(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+
+}
+
+func untraced(fn func([]byte, *porter2.Tracer) []byte) func([]byte) []byte {
+	return func(s []byte) []byte { return fn(s, nil) }
+}
+
+func TestM(t *testing.T) {
+	for w, want := range map[string]int{
+		"tr": 0, "ee": 0, "tree": 0, "y": 0, "by": 0,
+		"trouble": 1, "oats": 1, "trees": 1, "ivy": 1,
+		"troubles": 2, "private": 2, "oaten": 2, "orrery": 2,
+	} {
+		if got := m([]byte(w)); got != want {
+			t.Errorf("m(%q) = %d; want %d", w, got, want)
+		}
+	}
+}
+
+func TestCvc(t *testing.T) {
+	for w, want := range map[string]bool{
+		"wil": true, "hop": true, "fil": true,
+		"fail": false, "snow": false, "box": false, "tray": false,
+	} {
+		if got := cvc([]byte(w)); got != want {
+			t.Errorf("cvc(%q) = %v; want %v", w, got, want)
+		}
+	}
+}
+
+func TestStep1a(t *testing.T) {
+	pairs := []string{"caresses", "caress", "ponies", "poni", "ties", "ti", "caress", "caress", "cats", "cat"}
+	test(t, untraced(step1a), pairs)
+}
+
+func TestStep1b(t *testing.T) {
+	pairs := []string{"feed", "feed", "agreed", "agree", "plastered", "plaster", "bled", "bled", "motoring", "motor", "sing", "sing",
+		"conflated", "conflate", "troubled", "trouble", "sized", "size", "hopping", "hop", "tanned", "tan", "falling", "fall",
+		"hissing", "hiss", "fizzed", "fizz", "failing", "fail", "filing", "file"}
+	test(t, untraced(step1b), pairs)
+}
+
+func TestStep1c(t *testing.T) {
+	pairs := []string{"happy", "happi", "sky", "sky"}
+	test(t, step1c, pairs)
+}
+
+func TestStep2(t *testing.T) {
+	pairs := []string{"relational", "relate", "conditional", "condition", "rational", "rational", "valenci", "valence",
+		"digitizer", "digitize", "conformabli", "conformable", "vietnamization", "vietnamize", "sensibiliti", "sensible", "analogi", "analog"}
+	test(t, untraced(step2), pairs)
+}
+
+func TestStep3(t *testing.T) {
+	pairs := []string{"triplicate", "triplic", "formative", "form", "formalize", "formal", "electriciti", "electric", "hopeful", "hope", "goodness", "good"}
+	test(t, untraced(step3), pairs)
+}
+
+func TestStep4(t *testing.T) {
+	pairs := []string{"revival", "reviv", "allowance", "allow", "adoption", "adopt", "replacement", "replac", "dependent", "depend", "communion", "communion"}
+	test(t, untraced(step4), pairs)
+}
+
+func TestStep5(t *testing.T) {
+	pairs := []string{"probate", "probat", "rate", "rate", "cease", "ceas", "controll", "control", "roll", "roll"}
+	test(t, step5, pairs)
+}
@@ -0,0 +1,948 @@
+// Code generated by porter2gen. DO NOT EDIT.
+
+package porterenglish
+
+import (
+	. "xojoc.pw/nlp/stem/porter2"
+)
+
+func init() {
+	step1aStep.SetDo(step1aStepDo)
+	step1bStep.SetDo(step1bStepDo)
+	step2Step.SetDo(step2StepDo)
+	step3Step.SetDo(step3StepDo)
+	step4Step.SetDo(step4StepDo)
+}
+
+var step1bStepCallbacks = [...]func([]byte, []byte) []byte{
+	mGreater(0, Truncate("ee")),
+}
+
+var step1bStepSuffixes = [...][]byte{
+	[]byte("eed"),
+	[]byte("ed"),
+	[]byte("ing"),
+}
+
+var step2StepCallbacks = [...]func([]byte, []byte) []byte{
+	mGreater(0, Replace("ance")),
+	mGreater(0, Replace("ence")),
+	mGreater(0, Truncate("log")),
+	mGreater(0, Replace("ble")),
+	mGreater(0, Truncate("e")),
+	mGreater(0, Truncate("al")),
+	mGreater(0, Truncate("ous")),
+	mGreater(0, Truncate("ent")),
+	mGreater(0, Replace("ive")),
+	mGreater(0, Replace("ate")),
+	mGreater(0, Truncate("tion")),
+	mGreater(0, Replace("ize")),
+	mGreater(0, Truncate("ful")),
+}
+
+var step2StepSuffixes = [...][]byte{
+	[]byte("anci"),
+	[]byte("enci"),
+	[]byte("logi"),
+	[]byte("bli"),
+	[]byte("eli"),
+	[]byte("alli"),
+	[]byte("ousli"),
+	[]byte("entli"),
+	[]byte("aliti"),
+	[]byte("biliti"),
+	[]byte("iviti"),
+	[]byte("ational"),
+	[]byte("tional"),
+	[]byte("alism"),
+	[]byte("ization"),
+	[]byte("ation"),
+	[]byte("izer"),
+	[]byte("ator"),
+	[]byte("iveness"),
+	[]byte("fulness"),
+	[]byte("ousness"),
+}
+
+var step3StepCallbacks = [...]func([]byte, []byte) []byte{
+	mGreater(0, Truncate("ic")),
+	mGreater(0, Delete),
+	mGreater(0, Truncate("al")),
+}
+
+var step3StepSuffixes = [...][]byte{
+	[]byte("icate"),
+	[]byte("ative"),
+	[]byte("alize"),
+	[]byte("iciti"),
+	[]byte("ical"),
+	[]byte("ful"),
+	[]byte("ness"),
+}
+
+var step4StepCallbacks = [...]func([]byte, []byte) []byte{
+	mGreater(1, Delete),
+}
+
+var step4StepSuffixes = [...][]byte{
+	[]byte("ic"),
+	[]byte("ance"),
+	[]byte("ence"),
+	[]byte("able"),
+	[]byte("ible"),
+	[]byte("ate"),
+	[]byte("ive"),
+	[]byte("ize"),
+	[]byte("iti"),
+	[]byte("al"),
+	[]byte("ism"),
+	[]byte("ion"),
+	[]byte("er"),
+	[]byte("ous"),
+	[]byte("ant"),
+	[]byte("ement"),
+	[]byte("ment"),
+	[]byte("ent"),
+	[]byte("ou"),
+}
+
+func step1aStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := s[:len(s)-2]
+							return r, true
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 's':
+									r := s[:len(s)-2]
+									return r, true
+								}
+							}
+						}
+					}
+				case 's':
+					r := s[:len(s)-0]
+					return r, true
+				}
+			}
+			r := s[:len(s)-1]
+			return r, true
+		}
+	}
+	return s, false
+}
+
+func step1bStepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'd':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							r := step1bStepCallbacks[0](s, step1bStepSuffixes[0])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+					r := step1bDelete(s, step1bStepSuffixes[1])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'g':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := step1bDelete(s, step1bStepSuffixes[2])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step2StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									r := step2StepCallbacks[0](s, step2StepSuffixes[0])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								case 'e':
+									r := step2StepCallbacks[1](s, step2StepSuffixes[1])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				case 'g':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									r := step2StepCallbacks[2](s, step2StepSuffixes[2])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							r := step2StepCallbacks[3](s, step2StepSuffixes[3])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 'e':
+							r := step2StepCallbacks[4](s, step2StepSuffixes[4])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 'l':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									r := step2StepCallbacks[5](s, step2StepSuffixes[5])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						case 's':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'u':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'o':
+											r := step2StepCallbacks[6](s, step2StepSuffixes[6])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											r := step2StepCallbacks[7](s, step2StepSuffixes[7])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											r := step2StepCallbacks[5](s, step2StepSuffixes[8])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										case 'i':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'b':
+													r := step2StepCallbacks[3](s, step2StepSuffixes[9])
+													if r == nil {
+														return s, false
+													}
+													return r, true
+												}
+											}
+										}
+									}
+								case 'v':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											r := step2StepCallbacks[8](s, step2StepSuffixes[10])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'o':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 't':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'a':
+															r := step2StepCallbacks[9](s, step2StepSuffixes[11])
+															if r == nil {
+																return s, false
+															}
+															return r, true
+														}
+													}
+													r := step2StepCallbacks[10](s, step2StepSuffixes[12])
+													if r == nil {
+														return s, false
+													}
+													return r, true
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											r := step2StepCallbacks[5](s, step2StepSuffixes[13])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'z':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'i':
+															r := step2StepCallbacks[11](s, step2StepSuffixes[14])
+															if r == nil {
+																return s, false
+															}
+															return r, true
+														}
+													}
+												}
+											}
+											r := step2StepCallbacks[9](s, step2StepSuffixes[15])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'z':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									r := step2StepCallbacks[11](s, step2StepSuffixes[16])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 't':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									r := step2StepCallbacks[9](s, step2StepSuffixes[17])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'v':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'i':
+															r := step2StepCallbacks[8](s, step2StepSuffixes[18])
+															if r == nil {
+																return s, false
+															}
+															return r, true
+														}
+													}
+												}
+											}
+										case 'l':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'u':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'f':
+															r := step2StepCallbacks[12](s, step2StepSuffixes[19])
+															if r == nil {
+																return s, false
+															}
+															return r, true
+														}
+													}
+												}
+											}
+										case 's':
+											if len(s) > 5 {
+												switch s[len(s)-6] {
+												case 'u':
+													if len(s) > 6 {
+														switch s[len(s)-7] {
+														case 'o':
+															r := step2StepCallbacks[6](s, step2StepSuffixes[20])
+															if r == nil {
+																return s, false
+															}
+															return r, true
+														}
+													}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step3StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											r := step3StepCallbacks[0](s, step3StepSuffixes[0])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 't':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											r := step3StepCallbacks[1](s, step3StepSuffixes[1])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				case 'z':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'l':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'a':
+											r := step3StepCallbacks[2](s, step3StepSuffixes[2])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'c':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'i':
+											r := step3StepCallbacks[0](s, step3StepSuffixes[3])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'c':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'i':
+									r := step3StepCallbacks[0](s, step3StepSuffixes[4])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'f':
+							r := step3StepCallbacks[1](s, step3StepSuffixes[5])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'n':
+									r := step3StepCallbacks[1](s, step3StepSuffixes[6])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return s, false
+}
+
+func step4StepDo(s []byte) ([]byte, bool) {
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'c':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'i':
+					r := step4StepCallbacks[0](s, step4StepSuffixes[0])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'e':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'c':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'n':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									r := step4StepCallbacks[0](s, step4StepSuffixes[1])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								case 'e':
+									r := step4StepCallbacks[0](s, step4StepSuffixes[2])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				case 'l':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'b':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'a':
+									r := step4StepCallbacks[0](s, step4StepSuffixes[3])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								case 'i':
+									r := step4StepCallbacks[0](s, step4StepSuffixes[4])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+						}
+					}
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							r := step4StepCallbacks[0](s, step4StepSuffixes[5])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 'v':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := step4StepCallbacks[0](s, step4StepSuffixes[6])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				case 'z':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := step4StepCallbacks[0](s, step4StepSuffixes[7])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'i':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 't':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := step4StepCallbacks[0](s, step4StepSuffixes[8])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'l':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'a':
+					r := step4StepCallbacks[0](s, step4StepSuffixes[9])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 'm':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 's':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := step4StepCallbacks[0](s, step4StepSuffixes[10])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'n':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'i':
+							r := step4Ion(s, step4StepSuffixes[11])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'r':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'e':
+					r := step4StepCallbacks[0](s, step4StepSuffixes[12])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		case 's':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'u':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'o':
+							r := step4StepCallbacks[0](s, step4StepSuffixes[13])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 't':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'n':
+					if len(s) > 2 {
+						switch s[len(s)-3] {
+						case 'a':
+							r := step4StepCallbacks[0](s, step4StepSuffixes[14])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						case 'e':
+							if len(s) > 3 {
+								switch s[len(s)-4] {
+								case 'm':
+									if len(s) > 4 {
+										switch s[len(s)-5] {
+										case 'e':
+											r := step4StepCallbacks[0](s, step4StepSuffixes[15])
+											if r == nil {
+												return s, false
+											}
+											return r, true
+										}
+									}
+									r := step4StepCallbacks[0](s, step4StepSuffixes[16])
+									if r == nil {
+										return s, false
+									}
+									return r, true
+								}
+							}
+							r := step4StepCallbacks[0](s, step4StepSuffixes[17])
+							if r == nil {
+								return s, false
+							}
+							return r, true
+						}
+					}
+				}
+			}
+		case 'u':
+			if len(s) > 1 {
+				switch s[len(s)-2] {
+				case 'o':
+					r := step4StepCallbacks[0](s, step4StepSuffixes[18])
+					if r == nil {
+						return s, false
+					}
+					return r, true
+				}
+			}
+		}
+	}
+	return s, false
+}
@@ -0,0 +1,733 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package snowball
+
+// env is the state of a running program. Positions are rune indexes into
+// s. In backward mode the cursor c moves from l towards lb.
+type env struct {
+	s        []rune
+	c, l, lb int
+	bra, ket int
+	backward bool
+	ints     []int
+	bools    []bool
+	strs     [][]rune
+	amongs   []int // item found by the substring of each among
+}
+
+type command interface {
+	exec(e *env) bool
+}
+
+type expr interface {
+	eval(e *env) int
+}
+
+// replace replaces s[bra:ket] with r and adjusts the cursor and the
+// limit, like replace_s in the Snowball runtime.
+func (e *env) replace(bra, ket int, r []rune) int {
+	adj := len(r) - (ket - bra)
+	s := make([]rune, 0, len(e.s)+adj)
+	s = append(s, e.s[:bra]...)
+	s = append(s, r...)
+	s = append(s, e.s[ket:]...)
+	e.s = s
+	e.l += adj
+	if e.c >= ket {
+		e.c += adj
+	} else if e.c > bra {
+		e.c = bra
+	}
+	return adj
+}
+
+func (e *env) sliceOK() bool {
+	return 0 <= e.bra && e.bra <= e.ket && e.ket <= e.l && e.l <= len(e.s)
+}
+
+// eq reports whether r is at the cursor, in the direction of the mode.
+func (e *env) eq(r []rune) bool {
+	if e.backward {
+		if e.c-len(r) < e.lb {
+			return false
+		}
+		for i, x := range r {
+			if e.s[e.c-len(r)+i] != x {
+				return false
+			}
+		}
+		return true
+	}
+	if e.c+len(r) > e.l {
+		return false
+	}
+	for i, x := range r {
+		if e.s[e.c+i] != x {
+			return false
+		}
+	}
+	return true
+}
+
+// move moves the cursor n runes in the direction of the mode, if possible.
+func (e *env) move(n int) bool {
+	if n < 0 {
+		return false
+	}
+	if e.backward {
+		if e.c-n < e.lb {
+			return false
+		}
+		e.c -= n
+		return true
+	}
+	if e.c+n > e.l {
+		return false
+	}
+	e.c += n
+	return true
+}
+
+// atEnd reports whether the cursor can't move any further.
+func (e *env) atEnd() bool {
+	if e.backward {
+		return e.c <= e.lb
+	}
+	return e.c >= e.l
+}
+
+// cur returns the rune that would be passed over by moving the cursor.
+func (e *env) cur() (rune, bool) {
+	if e.atEnd() {
+		return 0, false
+	}
+	if e.backward {
+		return e.s[e.c-1], true
+	}
+	return e.s[e.c], true
+}
+
+type seq []command
+
+func (cs seq) exec(e *env) bool {
+	for _, c := range cs {
+		if !c.exec(e) {
+			return false
+		}
+	}
+	return true
+}
+
+type literal []rune
+
+func (x literal) exec(e *env) bool {
+	if !e.eq(x) {
+		return false
+	}
+	return e.move(len(x))
+}
+
+type strVar int
+
+func (x strVar) exec(e *env) bool {
+	return literal(e.strs[x]).exec(e)
+}
+
+type grouping struct {
+	name string
+	set  map[rune]bool
+}
+
+func (g *grouping) exec(e *env) bool {
+	r, ok := e.cur()
+	if !ok || !g.set[r] {
+		return false
+	}
+	return e.move(1)
+}
+
+type nonGrouping struct{ g *grouping }
+
+func (x nonGrouping) exec(e *env) bool {
+	r, ok := e.cur()
+	if !ok || x.g.set[r] {
+		return false
+	}
+	return e.move(1)
+}
+
+type boolVar int
+
+func (x boolVar) exec(e *env) bool {
+	return e.bools[x]
+}
+
+type setBool struct {
+	v     int
+	value bool
+}
+
+func (x setBool) exec(e *env) bool {
+	e.bools[x.v] = x.value
+	return true
+}
+
+type routine struct {
+	name     string
+	body     command
+	backward bool
+	line     int
+}
+
+type call struct {
+	r *routine
+}
+
+func (x call) exec(e *env) bool {
+	return x.r.body.exec(e)
+}
+
+type or struct{ a, b command }
+
+func (x or) exec(e *env) bool {
+	restore := e.save()
+	if x.a.exec(e) {
+		return true
+	}
+	restore()
+	return x.b.exec(e)
+}
+
+type and struct{ a, b command }
+
+func (x and) exec(e *env) bool {
+	restore := e.save()
+	if !x.a.exec(e) {
+		return false
+	}
+	restore()
+	return x.b.exec(e)
+}
+
+// save returns a function that restores the cursor. In backward mode
+// the text after the cursor can change, in forward mode the text before.
+func (e *env) save() func() {
+	if e.backward {
+		l := e.l - e.c
+		return func() { e.c = e.l - l }
+	}
+	c := e.c
+	return func() { e.c = c }
+}
+
+type not struct{ c command }
+
+func (x not) exec(e *env) bool {
+	restore := e.save()
+	if x.c.exec(e) {
+		return false
+	}
+	restore()
+	return true
+}
+
+type test struct{ c command }
+
+func (x test) exec(e *env) bool {
+	restore := e.save()
+	ok := x.c.exec(e)
+	restore()
+	return ok
+}
+
+type try struct{ c command }
+
+func (x try) exec(e *env) bool {
+	restore := e.save()
+	if !x.c.exec(e) {
+		restore()
+	}
+	return true
+}
+
+type do struct{ c command }
+
+func (x do) exec(e *env) bool {
+	restore := e.save()
+	x.c.exec(e)
+	restore()
+	return true
+}
+
+type fail struct{ c command }
+
+func (x fail) exec(e *env) bool {
+	x.c.exec(e)
+	return false
+}
+
+type gotoCmd struct {
+	c    command
+	past bool
+}
+
+func (x gotoCmd) exec(e *env) bool {
+	for {
+		restore := e.save()
+		if x.c.exec(e) {
+			if !x.past {
+				restore()
+			}
+			return true
+		}
+		restore()
+		if !e.move(1) {
+			return false
+		}
+	}
+}
+
+type repeat struct{ c command }
+
+func (x repeat) exec(e *env) bool {
+	for {
+		restore := e.save()
+		if !x.c.exec(e) {
+			restore()
+			return true
+		}
+	}
+}
+
+type loop struct {
+	n       expr
+	c       command
+	atleast bool
+}
+
+func (x loop) exec(e *env) bool {
+	for i := x.n.eval(e); i > 0; i-- {
+		if !x.c.exec(e) {
+			return false
+		}
+	}
+	if x.atleast {
+		return repeat{x.c}.exec(e)
+	}
+	return true
+}
+
+type hop struct{ n expr }
+
+func (x hop) exec(e *env) bool {
+	return e.move(x.n.eval(e))
+}
+
+type next struct{}
+
+func (next) exec(e *env) bool {
+	return e.move(1)
+}
+
+type backwards struct{ c command }
+
+func (x backwards) exec(e *env) bool {
+	if e.backward {
+		return x.c.exec(e)
+	}
+	lb := e.lb
+	e.lb, e.c = e.c, e.l
+	e.backward = true
+	ok := x.c.exec(e)
+	e.backward = false
+	e.c, e.lb = e.lb, lb
+	return ok
+}
+
+// reverse is like backwards but also turns backward mode into forward
+// mode, between lb and the cursor.
+type reverse struct{ c command }
+
+func (x reverse) exec(e *env) bool {
+	if !e.backward {
+		return backwards(x).exec(e)
+	}
+	gap := e.l - e.c
+	e.l, e.c = e.c, e.lb
+	e.backward = false
+	ok := x.c.exec(e)
+	e.backward = true
+	e.c = e.l
+	e.l += gap
+	return ok
+}
+
+// strCmd runs c on the string variable v, in forward mode, as if it
+// were the word. The word and its cursor, limits and slice are restored
+// afterwards.
+type strCmd struct {
+	v int
+	c command
+}
+
+func (x strCmd) exec(e *env) bool {
+	s, c, l, lb, bra, ket, backward := e.s, e.c, e.l, e.lb, e.bra, e.ket, e.backward
+	e.s = e.strs[x.v]
+	e.c, e.l, e.lb, e.bra, e.ket, e.backward = 0, len(e.s), 0, 0, len(e.s), false
+	ok := x.c.exec(e)
+	e.strs[x.v] = e.s
+	e.s, e.c, e.l, e.lb, e.bra, e.ket, e.backward = s, c, l, lb, bra, ket, backward
+	return ok
+}
+
+type setlimit struct{ limit, c command }
+
+func (x setlimit) exec(e *env) bool {
+	restore := e.save()
+	if !x.limit.exec(e) {
+		return false
+	}
+	m := e.c
+	restore()
+	if e.backward {
+		lb := e.lb
+		e.lb = m
+		ok := x.c.exec(e)
+		e.lb = lb
+		return ok
+	}
+	gap := e.l - m
+	e.l = m
+	ok := x.c.exec(e)
+	e.l += gap
+	return ok
+}
+
+type bra struct{}
+
+func (bra) exec(e *env) bool {
+	if e.backward {
+		e.ket = e.c
+	} else {
+		e.bra = e.c
+	}
+	return true
+}
+
+type ket struct{}
+
+func (ket) exec(e *env) bool {
+	if e.backward {
+		e.bra = e.c
+	} else {
+		e.ket = e.c
+	}
+	return true
+}
+
+// A stringArg is a string literal or a string variable.
+type stringArg struct {
+	lit []rune
+	v   int // -1 for literals
+}
+
+func (x stringArg) get(e *env) []rune {
+	if x.v < 0 {
+		return x.lit
+	}
+	return e.strs[x.v]
+}
+
+type sliceFrom struct{ s stringArg }
+
+func (x sliceFrom) exec(e *env) bool {
+	if !e.sliceOK() {
+		return false
+	}
+	e.replace(e.bra, e.ket, x.s.get(e))
+	return true
+}
+
+type sliceDel struct{}
+
+func (sliceDel) exec(e *env) bool {
+	return sliceFrom{stringArg{nil, -1}}.exec(e)
+}
+
+type sliceTo struct{ v int }
+
+func (x sliceTo) exec(e *env) bool {
+	if !e.sliceOK() {
+		return false
+	}
+	e.strs[x.v] = append([]rune(nil), e.s[e.bra:e.ket]...)
+	return true
+}
+
+type assignTo struct{ v int }
+
+func (x assignTo) exec(e *env) bool {
+	e.strs[x.v] = append([]rune(nil), e.s[e.c:e.l]...)
+	return true
+}
+
+type insert struct {
+	s      stringArg
+	attach bool
+}
+
+func (x insert) exec(e *env) bool {
+	c := e.c
+	adj := e.replace(c, c, x.s.get(e))
+	if c <= e.bra {
+		e.bra += adj
+	}
+	if c <= e.ket {
+		e.ket += adj
+	}
+	// the cursor ends up after the inserted string for <+ in forward
+	// mode, before it otherwise
+	if e.backward != x.attach {
+		e.c = c
+	}
+	return true
+}
+
+type setmark struct{ v int }
+
+func (x setmark) exec(e *env) bool {
+	e.ints[x.v] = e.c
+	return true
+}
+
+type tomark struct {
+	n  expr
+	at bool
+}
+
+func (x tomark) exec(e *env) bool {
+	n := x.n.eval(e)
+	if x.at {
+		return e.c == n
+	}
+	if e.backward {
+		if e.c < n || n < e.lb {
+			return false
+		}
+	} else if e.c > n || n > e.l {
+		return false
+	}
+	e.c = n
+	return true
+}
+
+type tolimit struct{ at bool }
+
+func (x tolimit) exec(e *env) bool {
+	l := e.l
+	if e.backward {
+		l = e.lb
+	}
+	if x.at {
+		return e.c == l
+	}
+	e.c = l
+	return true
+}
+
+type constant bool
+
+func (x constant) exec(e *env) bool {
+	return bool(x)
+}
+
+type assign struct {
+	v  int
+	op string
+	n  expr
+}
+
+func (x assign) exec(e *env) bool {
+	n := x.n.eval(e)
+	p := &e.ints[x.v]
+	switch x.op {
+	case "=":
+		*p = n
+	case "+=":
+		*p += n
+	case "-=":
+		*p -= n
+	case "*=":
+		*p *= n
+	case "/=":
+		if n == 0 {
+			return false
+		}
+		*p /= n
+	}
+	return true
+}
+
+type compare struct {
+	a, b expr
+	op   string
+}
+
+func (x compare) exec(e *env) bool {
+	a, b := x.a.eval(e), x.b.eval(e)
+	switch x.op {
+	case "==":
+		return a == b
+	case "!=":
+		return a != b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	}
+	return false
+}
+
+type amongItem struct {
+	s      []rune
+	cond   *routine
+	action int // index in among.actions, -1 if none
+}
+
+type among struct {
+	id      int
+	items   []amongItem // longest first
+	actions []command
+	// starter, if not nil, is the command in brackets at the start of
+	// the among, done after an item is matched and before its action.
+	starter command
+	// substring is true if the strings are matched by a preceding
+	// substring command
+	substring bool
+}
+
+// find finds the longest item matching at the cursor, whose condition
+// if any succeeds, and moves the cursor past it.
+func (x *among) find(e *env) int {
+	for i, it := range x.items {
+		if !e.eq(it.s) {
+			continue
+		}
+		restore := e.save()
+		e.move(len(it.s))
+		if it.cond == nil {
+			return i
+		}
+		moved := e.save()
+		ok := it.cond.body.exec(e)
+		moved()
+		if ok {
+			return i
+		}
+		restore()
+	}
+	return -1
+}
+
+func (x *among) exec(e *env) bool {
+	i := -1
+	if x.substring {
+		i = e.amongs[x.id]
+	} else {
+		i = x.find(e)
+	}
+	if i < 0 {
+		return false
+	}
+	if x.starter != nil && !x.starter.exec(e) {
+		return false
+	}
+	if a := x.items[i].action; a >= 0 {
+		return x.actions[a].exec(e)
+	}
+	return true
+}
+
+type substring struct{ a *among }
+
+func (x substring) exec(e *env) bool {
+	i := x.a.find(e)
+	e.amongs[x.a.id] = i
+	return i >= 0
+}
+
+// Integer expressions.
+
+type num int
+
+func (x num) eval(e *env) int { return int(x) }
+
+type intVar int
+
+func (x intVar) eval(e *env) int { return e.ints[x] }
+
+type special string
+
+func (x special) eval(e *env) int {
+	switch x {
+	case "limit":
+		return e.l
+	case "cursor":
+		return e.c
+	case "size", "len":
+		return len(e.s)
+	case "maxint":
+		return int(^uint32(0) >> 1)
+	case "minint":
+		return -int(^uint32(0)>>1) - 1
+	}
+	return 0
+}
+
+type sizeof struct{ s stringArg }
+
+func (x sizeof) eval(e *env) int { return len(x.s.get(e)) }
+
+type neg struct{ a expr }
+
+func (x neg) eval(e *env) int { return -x.a.eval(e) }
+
+type binary struct {
+	a, b expr
+	op   byte
+}
+
+func (x binary) eval(e *env) int {
+	a, b := x.a.eval(e), x.b.eval(e)
+	switch x.op {
+	case '+':
+		return a + b
+	case '-':
+		return a - b
+	case '*':
+		return a * b
+	case '/':
+		if b == 0 {
+			return 0
+		}
+		return a / b
+	}
+	return 0
+}
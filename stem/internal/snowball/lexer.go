@@ -0,0 +1,319 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package snowball
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tEOF tokenKind = iota
+	tName
+	tNum
+	tStr
+	tSym
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	str  []rune // value of a string literal
+	num  int
+	line int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tEOF:
+		return "end of file"
+	case tStr:
+		return strconv.Quote(string(t.str))
+	}
+	return t.text
+}
+
+var symbols = []string{"<-", "<+", "->", "=>", "==", "!=", "<=", ">=", "+=", "-=", "*=", "/=",
+	"(", ")", "[", "]", "+", "-", "*", "/", "=", "<", ">", "$", "?", ","}
+
+type lexer struct {
+	r    *bufio.Reader
+	line int
+
+	// open opens the files read by get, nil if get isn't allowed.
+	open func(name string) (io.ReadCloser, error)
+	// files read by get, the innermost last
+	files []*file
+
+	// set by the stringescapes and stringdef declarations
+	escOpen, escClose rune
+	defs              map[string][]rune
+}
+
+// file is a file read by get and where to resume the including one.
+type file struct {
+	c    io.Closer
+	r    *bufio.Reader
+	line int
+}
+
+func newLexer(r io.Reader, open func(string) (io.ReadCloser, error)) *lexer {
+	return &lexer{r: bufio.NewReader(r), line: 1, open: open, defs: map[string][]rune{}}
+}
+
+// get reads the file name before resuming the current one.
+func (l *lexer) get(name string) error {
+	if l.open == nil {
+		return l.errorf("get %q: can't read files", name)
+	}
+	if len(l.files) >= 16 {
+		return l.errorf("get %q: too many nested files", name)
+	}
+	f, err := l.open(name)
+	if err != nil {
+		return l.errorf("get %q: %v", name, err)
+	}
+	l.files = append(l.files, &file{f, l.r, l.line})
+	l.r, l.line = bufio.NewReader(f), 1
+	return nil
+}
+
+// close closes the files read by get.
+func (l *lexer) close() {
+	for _, f := range l.files {
+		f.c.Close()
+	}
+	l.files = nil
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("snowball: line %d: %s", l.line, fmt.Sprintf(format, args...))
+}
+
+func (l *lexer) read() rune {
+	r, _, err := l.r.ReadRune()
+	for err != nil && len(l.files) > 0 {
+		f := l.files[len(l.files)-1]
+		l.files = l.files[:len(l.files)-1]
+		f.c.Close()
+		l.r, l.line = f.r, f.line
+		r, _, err = l.r.ReadRune()
+	}
+	if err != nil {
+		return -1
+	}
+	if r == '\n' {
+		l.line++
+	}
+	return r
+}
+
+func (l *lexer) unread(r rune) {
+	if r < 0 {
+		return
+	}
+	l.r.UnreadRune()
+	if r == '\n' {
+		l.line--
+	}
+}
+
+func (l *lexer) peek() rune {
+	r := l.read()
+	l.unread(r)
+	return r
+}
+
+// skip skips white space and comments.
+func (l *lexer) skip() error {
+	for {
+		r := l.read()
+		switch {
+		case r < 0:
+			return nil
+		case unicode.IsSpace(r):
+		case r == '/' && l.peek() == '/':
+			for r >= 0 && r != '\n' {
+				r = l.read()
+			}
+		case r == '/' && l.peek() == '*':
+			l.read()
+			for prev := rune(0); ; prev = r {
+				r = l.read()
+				if r < 0 {
+					return l.errorf("unterminated comment")
+				}
+				if prev == '*' && r == '/' {
+					break
+				}
+			}
+		default:
+			l.unread(r)
+			return nil
+		}
+	}
+}
+
+// raw returns the next run of non space characters. It is used for the
+// arguments of stringescapes and stringdef.
+func (l *lexer) raw() (string, error) {
+	if err := l.skip(); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for {
+		r := l.read()
+		if r < 0 || unicode.IsSpace(r) {
+			l.unread(r)
+			break
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 {
+		return "", l.errorf("unexpected end of file")
+	}
+	return b.String(), nil
+}
+
+func isNameRune(r rune) bool {
+	return r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+func (l *lexer) next() (token, error) {
+	if err := l.skip(); err != nil {
+		return token{}, err
+	}
+	t := token{line: l.line}
+	r := l.read()
+	switch {
+	case r < 0:
+		t.kind = tEOF
+	case r >= '0' && r <= '9':
+		t.kind = tNum
+		for r >= '0' && r <= '9' {
+			t.num = t.num*10 + int(r-'0')
+			r = l.read()
+		}
+		l.unread(r)
+		t.text = strconv.Itoa(t.num)
+	case isNameRune(r):
+		t.kind = tName
+		var b strings.Builder
+		for isNameRune(r) {
+			b.WriteRune(r)
+			r = l.read()
+		}
+		l.unread(r)
+		t.text = b.String()
+		if t.text == "get" {
+			n, err := l.next()
+			if err != nil {
+				return n, err
+			}
+			if n.kind != tStr {
+				return n, l.errorf("expected a file name after get, found %s", n)
+			}
+			if err := l.get(string(n.str)); err != nil {
+				return n, err
+			}
+			return l.next()
+		}
+	case r == '\'':
+		t.kind = tStr
+		s, err := l.str()
+		if err != nil {
+			return t, err
+		}
+		t.str = s
+	default:
+		t.kind = tSym
+		s := string(r)
+		if n := l.peek(); n >= 0 {
+			for _, sym := range symbols {
+				if len(sym) == 2 && sym == s+string(n) {
+					l.read()
+					s = sym
+					break
+				}
+			}
+		}
+		for _, sym := range symbols {
+			if sym == s {
+				t.text = s
+				return t, nil
+			}
+		}
+		return t, l.errorf("unexpected %q", s)
+	}
+	return t, nil
+}
+
+// str reads a string literal after the opening quote.
+func (l *lexer) str() ([]rune, error) {
+	var s []rune
+	for {
+		r := l.read()
+		switch {
+		case r < 0 || r == '\n':
+			return nil, l.errorf("unterminated string")
+		case r == '\'':
+			return s, nil
+		case l.escOpen != 0 && r == l.escOpen:
+			var name []rune
+			for {
+				r = l.read()
+				if r < 0 || r == '\n' {
+					return nil, l.errorf("unterminated escape")
+				}
+				if r == l.escClose && len(name) > 0 {
+					break
+				}
+				name = append(name, r)
+			}
+			e, err := l.escape(string(name))
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, e...)
+		default:
+			s = append(s, r)
+		}
+	}
+}
+
+func (l *lexer) escape(name string) ([]rune, error) {
+	switch {
+	case name == "'" || name == string(l.escOpen) || name == string(l.escClose):
+		return []rune(name), nil
+	case strings.HasPrefix(name, "U+"):
+		n, err := strconv.ParseUint(name[2:], 16, 32)
+		if err != nil {
+			return nil, l.errorf("bad escape {%s}", name)
+		}
+		return []rune{rune(n)}, nil
+	}
+	s, ok := l.defs[name]
+	if !ok {
+		return nil, l.errorf("undefined string escape %q", name)
+	}
+	return s, nil
+}
@@ -0,0 +1,800 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package snowball
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+type kind int
+
+const (
+	kUndeclared kind = iota
+	kRoutine
+	kExternal
+	kInteger
+	kBoolean
+	kString
+	kGrouping
+)
+
+type symbol struct {
+	kind     kind
+	index    int // of integers, booleans and strings
+	routine  *routine
+	grouping *grouping
+}
+
+type parser struct {
+	lex      *lexer
+	tok      token
+	backward bool
+
+	symbols   map[string]*symbol
+	nints     int
+	nbools    int
+	nstrs     int
+	namongs   int
+	substring *among // among to be used by the last substring
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("snowball: line %d: %s", p.tok.line, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) next() error {
+	t, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = t
+	return nil
+}
+
+func (p *parser) is(text string) bool {
+	return (p.tok.kind == tSym || p.tok.kind == tName) && p.tok.text == text
+}
+
+func (p *parser) expect(text string) error {
+	if !p.is(text) {
+		return p.errorf("expected %s, found %s", text, p.tok)
+	}
+	return p.next()
+}
+
+func (p *parser) name() (string, error) {
+	if p.tok.kind != tName || keywords[p.tok.text] {
+		return "", p.errorf("expected a name, found %s", p.tok)
+	}
+	n := p.tok.text
+	return n, p.next()
+}
+
+// lookup returns the symbol name, which must be of one of kinds.
+func (p *parser) lookup(name string, kinds ...kind) (*symbol, error) {
+	s, ok := p.symbols[name]
+	if !ok {
+		return nil, p.errorf("%s undeclared", name)
+	}
+	for _, k := range kinds {
+		if s.kind == k {
+			return s, nil
+		}
+	}
+	return nil, p.errorf("%s has the wrong type", name)
+}
+
+var keywords = map[string]bool{}
+
+func init() {
+	for _, k := range []string{"as", "do", "or", "and", "not", "test", "try", "fail",
+		"goto", "gopast", "repeat", "loop", "atleast", "backwards", "reverse",
+		"setlimit", "for", "among", "substring", "delete", "next", "hop",
+		"set", "unset", "setmark", "tomark", "atmark", "tolimit", "atlimit",
+		"insert", "attach", "true", "false", "non", "define", "routines",
+		"externals", "integers", "booleans", "strings", "groupings",
+		"stringescapes", "stringdef", "backwardmode", "hex", "decimal",
+		"limit", "cursor", "size", "sizeof", "len", "lenof", "maxint", "minint",
+		"get"} {
+		keywords[k] = true
+	}
+}
+
+func (p *parser) declarations(end string) error {
+	for !(p.tok.kind == tEOF && end == "") && !p.is(end) {
+		if p.tok.kind == tEOF {
+			return p.errorf("unexpected end of file")
+		}
+		if p.tok.kind != tName {
+			return p.errorf("unexpected %s", p.tok)
+		}
+		var err error
+		switch p.tok.text {
+		case "routines":
+			err = p.declare(kRoutine)
+		case "externals":
+			err = p.declare(kExternal)
+		case "integers":
+			err = p.declare(kInteger)
+		case "booleans":
+			err = p.declare(kBoolean)
+		case "strings":
+			err = p.declare(kString)
+		case "groupings":
+			err = p.declare(kGrouping)
+		case "stringescapes":
+			err = p.stringescapes()
+		case "stringdef":
+			err = p.stringdef()
+		case "define":
+			err = p.define()
+		case "backwardmode":
+			if err = p.next(); err != nil {
+				return err
+			}
+			if err = p.expect("("); err != nil {
+				return err
+			}
+			p.backward = true
+			if err = p.declarations(")"); err != nil {
+				return err
+			}
+			p.backward = false
+			err = p.expect(")")
+		default:
+			return p.errorf("unexpected %s", p.tok)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parser) declare(k kind) error {
+	if err := p.next(); err != nil {
+		return err
+	}
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	for !p.is(")") {
+		n, err := p.name()
+		if err != nil {
+			return err
+		}
+		if _, dup := p.symbols[n]; dup {
+			return p.errorf("%s declared twice", n)
+		}
+		s := &symbol{kind: k}
+		switch k {
+		case kRoutine, kExternal:
+			s.routine = &routine{name: n}
+		case kGrouping:
+			s.grouping = &grouping{name: n}
+		case kInteger:
+			s.index = p.nints
+			p.nints++
+		case kBoolean:
+			s.index = p.nbools
+			p.nbools++
+		case kString:
+			s.index = p.nstrs
+			p.nstrs++
+		}
+		p.symbols[n] = s
+	}
+	return p.next()
+}
+
+func (p *parser) stringescapes() error {
+	s, err := p.lex.raw()
+	if err != nil {
+		return err
+	}
+	r := []rune(s)
+	if len(r) != 2 {
+		return p.errorf("stringescapes needs two characters")
+	}
+	p.lex.escOpen, p.lex.escClose = r[0], r[1]
+	return p.next()
+}
+
+func (p *parser) stringdef() error {
+	name, err := p.lex.raw()
+	if err != nil {
+		return err
+	}
+	if err := p.next(); err != nil {
+		return err
+	}
+	base := 0
+	if p.is("hex") {
+		base = 16
+	} else if p.is("decimal") {
+		base = 10
+	}
+	if base != 0 {
+		if err := p.next(); err != nil {
+			return err
+		}
+	}
+	if p.tok.kind != tStr {
+		return p.errorf("expected a string, found %s", p.tok)
+	}
+	s := p.tok.str
+	if base != 0 {
+		s = nil
+		for _, f := range splitSpace(string(p.tok.str)) {
+			n, err := strconv.ParseUint(f, base, 32)
+			if err != nil {
+				return p.errorf("bad number %q in stringdef", f)
+			}
+			s = append(s, rune(n))
+		}
+	}
+	p.lex.defs[name] = s
+	return p.next()
+}
+
+func splitSpace(s string) []string {
+	var fs []string
+	f := ""
+	for _, r := range s + " " {
+		if r == ' ' || r == '\t' {
+			if f != "" {
+				fs = append(fs, f)
+			}
+			f = ""
+		} else {
+			f += string(r)
+		}
+	}
+	return fs
+}
+
+func (p *parser) define() error {
+	if err := p.next(); err != nil {
+		return err
+	}
+	line := p.tok.line
+	n, err := p.name()
+	if err != nil {
+		return err
+	}
+	s, err := p.lookup(n, kRoutine, kExternal, kGrouping)
+	if err != nil {
+		return err
+	}
+	if s.kind == kGrouping {
+		if s.grouping.set != nil {
+			return p.errorf("%s defined twice", n)
+		}
+		set, err := p.groupingExpr()
+		if err != nil {
+			return err
+		}
+		s.grouping.set = set
+		return nil
+	}
+	if s.routine.body != nil {
+		return p.errorf("%s defined twice", n)
+	}
+	if err := p.expect("as"); err != nil {
+		return err
+	}
+	s.routine.line = line
+	s.routine.backward = p.backward
+	p.substring = nil
+	body, err := p.command()
+	if err != nil {
+		return err
+	}
+	if p.substring != nil {
+		return p.errorf("substring without among in %s", n)
+	}
+	s.routine.body = body
+	return nil
+}
+
+func (p *parser) groupingExpr() (map[rune]bool, error) {
+	set := map[rune]bool{}
+	op := "+"
+	for {
+		var rs []rune
+		switch p.tok.kind {
+		case tStr:
+			rs = p.tok.str
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		case tName:
+			n, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			s, err := p.lookup(n, kGrouping)
+			if err != nil {
+				return nil, err
+			}
+			if s.grouping.set == nil {
+				return nil, p.errorf("grouping %s used before its definition", n)
+			}
+			for r := range s.grouping.set {
+				rs = append(rs, r)
+			}
+		default:
+			return nil, p.errorf("expected a grouping or a string, found %s", p.tok)
+		}
+		for _, r := range rs {
+			set[r] = op == "+"
+		}
+		if !p.is("+") && !p.is("-") {
+			break
+		}
+		op = p.tok.text
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	}
+	for r, in := range set {
+		if !in {
+			delete(set, r)
+		}
+	}
+	return set, nil
+}
+
+// command parses a command, possibly joined to the following ones by
+// and/or.
+func (p *parser) command() (command, error) {
+	c, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.is("or") || p.is("and") {
+		op := p.tok.text
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		d, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if op == "or" {
+			c = or{c, d}
+		} else {
+			c = and{c, d}
+		}
+	}
+	return c, nil
+}
+
+func (p *parser) list(end string) (seq, error) {
+	var cs seq
+	for !p.is(end) {
+		if p.tok.kind == tEOF {
+			return nil, p.errorf("unexpected end of file")
+		}
+		c, err := p.command()
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, c)
+	}
+	return cs, p.next()
+}
+
+func (p *parser) stringArg() (stringArg, error) {
+	if p.tok.kind == tStr {
+		s := p.tok.str
+		return stringArg{s, -1}, p.next()
+	}
+	n, err := p.name()
+	if err != nil {
+		return stringArg{}, err
+	}
+	s, err := p.lookup(n, kString)
+	if err != nil {
+		return stringArg{}, err
+	}
+	return stringArg{nil, s.index}, nil
+}
+
+func (p *parser) varOf(k kind) (int, error) {
+	n, err := p.name()
+	if err != nil {
+		return 0, err
+	}
+	s, err := p.lookup(n, k)
+	if err != nil {
+		return 0, err
+	}
+	return s.index, nil
+}
+
+func (p *parser) unary() (command, error) {
+	t := p.tok
+	if t.kind == tStr {
+		return literal(t.str), p.next()
+	}
+	if t.kind == tName && !keywords[t.text] {
+		n, _ := p.name()
+		s, err := p.lookup(n, kRoutine, kExternal, kGrouping, kBoolean, kString)
+		if err != nil {
+			return nil, err
+		}
+		switch s.kind {
+		case kGrouping:
+			return s.grouping, nil
+		case kBoolean:
+			return boolVar(s.index), nil
+		case kString:
+			return strVar(s.index), nil
+		}
+		return call{s.routine}, nil
+	}
+	if t.kind != tName && t.kind != tSym {
+		return nil, p.errorf("unexpected %s", t)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	switch t.text {
+	case "(":
+		return p.list(")")
+	case "not", "test", "try", "do", "fail", "goto", "gopast", "repeat", "backwards", "reverse":
+		c, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		switch t.text {
+		case "not":
+			return not{c}, nil
+		case "test":
+			return test{c}, nil
+		case "try":
+			return try{c}, nil
+		case "do":
+			return do{c}, nil
+		case "fail":
+			return fail{c}, nil
+		case "goto":
+			return gotoCmd{c, false}, nil
+		case "gopast":
+			return gotoCmd{c, true}, nil
+		case "repeat":
+			return repeat{c}, nil
+		case "reverse":
+			return reverse{c}, nil
+		}
+		return backwards{c}, nil
+	case "loop", "atleast":
+		n, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		c, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return loop{n, c, t.text == "atleast"}, nil
+	case "hop":
+		n, err := p.expr()
+		return hop{n}, err
+	case "next":
+		return next{}, nil
+	case "setlimit":
+		l, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("for"); err != nil {
+			return nil, err
+		}
+		c, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return setlimit{l, c}, nil
+	case "among":
+		return p.among()
+	case "substring":
+		a := &among{id: p.namongs, substring: true}
+		p.namongs++
+		p.substring = a
+		return substring{a}, nil
+	case "[":
+		return bra{}, nil
+	case "]":
+		return ket{}, nil
+	case "delete":
+		return sliceDel{}, nil
+	case "<-":
+		s, err := p.stringArg()
+		return sliceFrom{s}, err
+	case "<+", "insert", "attach":
+		s, err := p.stringArg()
+		return insert{s, t.text == "attach"}, err
+	case "->":
+		v, err := p.varOf(kString)
+		return sliceTo{v}, err
+	case "=>":
+		v, err := p.varOf(kString)
+		return assignTo{v}, err
+	case "set", "unset":
+		v, err := p.varOf(kBoolean)
+		return setBool{v, t.text == "set"}, err
+	case "setmark":
+		v, err := p.varOf(kInteger)
+		return setmark{v}, err
+	case "tomark", "atmark":
+		n, err := p.expr()
+		return tomark{n, t.text == "atmark"}, err
+	case "tolimit", "atlimit":
+		return tolimit{t.text == "atlimit"}, nil
+	case "true":
+		return constant(true), nil
+	case "false":
+		return constant(false), nil
+	case "?":
+		return constant(true), nil
+	case "non":
+		if p.is("-") {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+		n, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		s, err := p.lookup(n, kGrouping)
+		if err != nil {
+			return nil, err
+		}
+		return nonGrouping{s.grouping}, nil
+	case "$":
+		return p.dollar()
+	}
+	return nil, p.errorf("unexpected %s", t)
+}
+
+var assignOps = map[string]bool{"=": true, "+=": true, "-=": true, "*=": true, "/=": true}
+var compareOps = map[string]bool{"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true}
+
+func (p *parser) dollar() (command, error) {
+	if p.is("(") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		a, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		op := p.tok.text
+		if p.tok.kind != tSym || !compareOps[op] {
+			return nil, p.errorf("expected a comparison, found %s", p.tok)
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		b, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		return compare{a, b, op}, p.expect(")")
+	}
+	n, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	s, err := p.lookup(n, kInteger, kString)
+	if err != nil {
+		return nil, err
+	}
+	if s.kind == kString {
+		c, err := p.unary()
+		return strCmd{s.index, c}, err
+	}
+	op := p.tok.text
+	if p.tok.kind != tSym || !assignOps[op] && !compareOps[op] {
+		return nil, p.errorf("expected an assignment or a comparison, found %s", p.tok)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	x, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if assignOps[op] {
+		return assign{s.index, op, x}, nil
+	}
+	return compare{intVar(s.index), x, op}, nil
+}
+
+func (p *parser) among() (command, error) {
+	a := p.substring
+	p.substring = nil
+	if a == nil {
+		a = &among{id: p.namongs}
+		p.namongs++
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	group := len(a.items)
+	for !p.is(")") {
+		switch {
+		case p.tok.kind == tStr:
+			it := amongItem{s: p.tok.str, action: -1}
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			if p.tok.kind == tName && !keywords[p.tok.text] {
+				n, _ := p.name()
+				s, err := p.lookup(n, kRoutine)
+				if err != nil {
+					return nil, err
+				}
+				it.cond = s.routine
+			}
+			for _, x := range a.items {
+				if string(x.s) == string(it.s) && x.cond == it.cond {
+					return nil, p.errorf("%q repeated in among", string(it.s))
+				}
+			}
+			a.items = append(a.items, it)
+		case p.is("("):
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			c, err := p.list(")")
+			if err != nil {
+				return nil, err
+			}
+			if len(a.items) == 0 && len(a.actions) == 0 && a.starter == nil {
+				a.starter = c
+				continue
+			}
+			for i := group; i < len(a.items); i++ {
+				a.items[i].action = len(a.actions)
+			}
+			a.actions = append(a.actions, c)
+			group = len(a.items)
+		default:
+			return nil, p.errorf("unexpected %s in among", p.tok)
+		}
+	}
+	// longest first, items with conditions before those without
+	sort.SliceStable(a.items, func(i, j int) bool {
+		return len(a.items[i].s) > len(a.items[j].s)
+	})
+	return a, p.next()
+}
+
+func (p *parser) expr() (expr, error) {
+	x, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tSym && (p.tok.text == "+" || p.tok.text == "-") {
+		op := p.tok.text[0]
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		y, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		x = binary{x, y, op}
+	}
+	return x, nil
+}
+
+func (p *parser) term() (expr, error) {
+	x, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tSym && (p.tok.text == "*" || p.tok.text == "/") {
+		op := p.tok.text[0]
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		y, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		x = binary{x, y, op}
+	}
+	return x, nil
+}
+
+func (p *parser) factor() (expr, error) {
+	t := p.tok
+	switch {
+	case t.kind == tNum:
+		return num(t.num), p.next()
+	case p.is("-"):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.factor()
+		return neg{x}, err
+	case p.is("("):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		return x, p.expect(")")
+	case p.is("limit"), p.is("cursor"), p.is("size"), p.is("len"), p.is("maxint"), p.is("minint"):
+		return special(t.text), p.next()
+	case p.is("sizeof"), p.is("lenof"):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		s, err := p.stringArg()
+		return sizeof{s}, err
+	case t.kind == tName && !keywords[t.text]:
+		v, err := p.varOf(kInteger)
+		return intVar(v), err
+	}
+	return nil, p.errorf("expected an integer expression, found %s", t)
+}
+
+// parse parses the program read from r. The files read by get are
+// opened with open.
+func parse(r io.Reader, open func(string) (io.ReadCloser, error)) (*Program, error) {
+	p := &parser{lex: newLexer(r, open), symbols: map[string]*symbol{}}
+	defer p.lex.close()
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if err := p.declarations(""); err != nil {
+		return nil, err
+	}
+	for n, s := range p.symbols {
+		switch s.kind {
+		case kRoutine, kExternal:
+			if s.routine.body == nil {
+				return nil, fmt.Errorf("snowball: routine %s is not defined", n)
+			}
+		case kGrouping:
+			if s.grouping.set == nil {
+				return nil, fmt.Errorf("snowball: grouping %s is not defined", n)
+			}
+		}
+	}
+	s, ok := p.symbols["stem"]
+	if !ok || s.kind != kExternal {
+		return nil, fmt.Errorf("snowball: no external stem routine")
+	}
+	prog := &Program{stem: s.routine, ints: p.nints, bools: p.nbools, strs: p.nstrs, amongs: p.namongs}
+	for _, r := range regionMarks {
+		if s, ok := p.symbols[r.mark]; ok && s.kind == kInteger {
+			prog.regions = append(prog.regions, region{r.name, s.index})
+		}
+	}
+	return prog, nil
+}
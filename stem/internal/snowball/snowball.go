@@ -0,0 +1,133 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Package snowball is an interpreter for the Snowball string processing
+// language, in which the stemmers of snowballstem.org are written.
+//
+// A program must define the external routine stem, which is run on each
+// word. The regions used by the algorithms (R1, R2, RV) are computed by
+// the programs themselves with setmark and tested with $p1 <= cursor or
+// setlimit tomark p1 for (...), exactly like in the official sources;
+// Regions returns them like the region functions of package porter2.
+// The files read by get are opened relative to the working directory.
+package snowball
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+)
+
+// http://snowballstem.org/compiler/snowman.html
+
+// Program is a parsed Snowball program. It's safe for concurrent use.
+type Program struct {
+	stem    *routine
+	ints    int
+	bools   int
+	strs    int
+	amongs  int
+	regions []region
+}
+
+// region is a region marked by the program in an integer.
+type region struct {
+	name string
+	v    int
+}
+
+// regionMarks maps the integers used by the official sources to mark the
+// regions to the names of the regions in package porter2.
+var regionMarks = []struct{ name, mark string }{
+	{"R1", "p1"},
+	{"R2", "p2"},
+	{"RV", "pV"},
+}
+
+// Parse parses the Snowball program read from r.
+func Parse(r io.Reader) (*Program, error) {
+	return parse(r, func(name string) (io.ReadCloser, error) {
+		return os.Open(name)
+	})
+}
+
+func (p *Program) newEnv(s []rune) *env {
+	return &env{
+		s:      s,
+		l:      len(s),
+		ket:    len(s),
+		ints:   make([]int, p.ints),
+		bools:  make([]bool, p.bools),
+		strs:   make([][]rune, p.strs),
+		amongs: make([]int, p.amongs),
+	}
+}
+
+func (p *Program) run(s []rune) *env {
+	e := p.newEnv(s)
+	p.stem.body.exec(e)
+	return e
+}
+
+// StemBytes runs the stem routine on s. Invalid UTF-8 is left as is.
+func (p *Program) StemBytes(s []byte) []byte {
+	if !utf8.Valid(s) {
+		return s
+	}
+	e := p.run(bytes.Runes(s))
+	return append(s[:0], string(e.s)...)
+}
+
+func (p *Program) StemString(s string) string {
+	if !utf8.ValidString(s) {
+		return s
+	}
+	return string(p.run([]rune(s)).s)
+}
+
+// Regions runs the stem routine on s and returns the regions it marked,
+// named like in package porter2: R1 for the integer p1, R2 for p2 and RV
+// for pV. Integers the program doesn't declare are left out. Each region
+// is a suffix of s, like the ones returned by porter2.R1; the marks are
+// taken as rune offsets into s, so a prelude changing the length of the
+// word shifts them.
+func (p *Program) Regions(s []byte) map[string][]byte {
+	rs := map[string][]byte{}
+	if !utf8.Valid(s) {
+		return rs
+	}
+	e := p.run(bytes.Runes(s))
+	for _, r := range p.regions {
+		i, n := 0, e.ints[r.v]
+		for n > 0 && i < len(s) {
+			_, l := utf8.DecodeRune(s[i:])
+			i += l
+			n--
+		}
+		rs[r.name] = s[i:]
+	}
+	return rs
+}
+
+func NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+
+func NormalizeString(s string) string {
+	return string(NormalizeBytes([]byte(s)))
+}
@@ -0,0 +1,174 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package snowball
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"gitlab.com/xojoc/util"
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+func mustParse(t *testing.T, src string) *Program {
+	p, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func testStem(t *testing.T, src string, pairs []string) {
+	p := mustParse(t, src)
+	for i := 0; i < len(pairs); i += 2 {
+		actual := p.StemString(pairs[i])
+		if actual != pairs[i+1] {
+			t.Errorf("StemString(%q) = %v; want %v", pairs[i], actual, pairs[i+1])
+		}
+	}
+}
+
+const regions = `
+integers ( p1 p2 pV )
+externals ( stem )
+groupings ( v )
+stringescapes {}
+define v 'aeiou{U+00E1}{U+00E9}{U+00ED}{U+00F3}{U+00FA}{U+00FC}'
+define stem as (
+    $p1 = limit
+    $p2 = limit
+    $pV = limit
+    do (
+        gopast v  gopast non-v  setmark p1
+        gopast v  gopast non-v  setmark p2
+    )
+    do (
+        ( v (non-v gopast v) or (v gopast non-v) )
+        or
+        ( non-v (non-v gopast v) or (v next) )
+        setmark pV
+    )
+)
+`
+
+// TestRegions checks that the regions marked the Snowball way are the
+// ones of porter2.R1, porter2.R2 and porter2.SpanishRV.
+func TestRegions(t *testing.T) {
+	const vowels = "aeiouáéíóúü"
+	r1, r2, rv := porter2.R1(vowels), porter2.R2(vowels), porter2.SpanishRV(vowels)
+	p := mustParse(t, regions)
+	f := util.MustOpen("../porter2spanish/testfiles/vocabulary.txt")
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		w := []byte(fields[0])
+		rs := p.Regions(w)
+		for name, r := range map[string]func([]byte) []byte{"R1": r1, "R2": r2, "RV": rv} {
+			if got, want := string(rs[name]), string(r(w)); got != want {
+				t.Errorf("%q: %s is %q, want %q", w, name, got, want)
+			}
+		}
+	}
+	util.Fatal(scanner.Err())
+}
+
+func TestCommands(t *testing.T) {
+	testStem(t, `externals ( stem ) define stem as ( [ 'a' ] <- 'bb' )`,
+		[]string{"ac", "bbc", "c", "c"})
+	testStem(t, `externals ( stem ) define stem as ( hop 1 <+ 'x' 'b' <+ 'y' )`,
+		[]string{"abc", "axbyc", "acb", "axcb"})
+	testStem(t, `externals ( stem ) define stem as backwards ( 'c' <+ 'x' 'b' attach 'y' )`,
+		[]string{"abc", "aybxc"})
+	testStem(t, `externals ( stem ) define stem as repeat ( goto 'x' [next] delete )`,
+		[]string{"axbxc", "abc", "xxxx", ""})
+	testStem(t, `externals ( stem ) define stem as ( loop 2 ( gopast 'a' ) [ tolimit ] delete )`,
+		[]string{"babab", "baba", "bab", "bab"})
+	testStem(t, `externals ( stem ) define stem as atleast 1 ( gopast 'a' [ 'b' ] <- 'c' )`,
+		[]string{"ababxab", "acacxac", "xx", "xx"})
+	testStem(t, `integers ( n ) externals ( stem ) define stem as ( $n = len * 2 - 1 $n > 5 hop 1 [ next ] delete )`,
+		[]string{"abcd", "acd", "abc", "abc"})
+	testStem(t, `integers ( n ) externals ( stem ) define stem as ( $(len == 3) [ hop 3 ] <- 'three' )`,
+		[]string{"abc", "three", "ab", "ab"})
+	testStem(t, `booleans ( b ) externals ( stem ) define stem as ( try ( 'a' set b ) tolimit b <+ '!' )`,
+		[]string{"ab", "ab!", "bb", "bb"})
+	testStem(t, `strings ( s ) externals ( stem ) define stem as ( [ hop 2 ] -> s tolimit <+ s )`,
+		[]string{"abc", "abcab"})
+	testStem(t, `routines ( r ) externals ( stem ) define r as 'x'
+		define stem as ( [substring] among ( 'a' r 'ab' r (<- '1') 'b' (<- '2') ) )`,
+		[]string{"abx", "1x", "ax", "1x", "ab", "ab", "b", "2"})
+	testStem(t, `externals ( stem ) define stem as backwards ( [substring] among ( ( 'x' ) 'a' (<- '1') 'b' (<- '2') ) )`,
+		[]string{"xa", "x1", "xb", "x2", "ya", "ya", "a", "a"})
+	testStem(t, `externals ( stem ) define stem as backwards setlimit ( hop 2 ) for ( [ gopast 'a' ] delete )`,
+		[]string{"aaba", "aab", "aab", "a", "bb", "bb"})
+	testStem(t, `externals ( stem ) define stem as ( setlimit hop 2 for ( gopast 'b' ) [ next ] <- 'x' )`,
+		[]string{"abab", "abxb", "aabb", "aabb"})
+	testStem(t, `externals ( stem ) define stem as backwards ( 'c' reverse ( 'a' [ next ] <- 'xy' ) 'y' 'x' <+ '-' )`,
+		[]string{"abc", "a-xyc", "bbc", "bbc"})
+	testStem(t, `externals ( stem ) define stem as reverse ( [ 'b' ] delete )`,
+		[]string{"ab", "a", "ba", "ba"})
+	testStem(t, `strings ( s ) externals ( stem ) define stem as ( [ hop 2 ] -> s $s ( hop 1 <+ '-' ) $s 'a' tolimit <+ s )`,
+		[]string{"abc", "abca-b", "bcd", "bcd"})
+	testStem(t, `strings ( s ) externals ( stem ) define stem as ( [ hop 2 ] -> s $s backwards ( [ next ] delete ) <- s )`,
+		[]string{"abc", "ac"})
+}
+
+func TestGet(t *testing.T) {
+	testStem(t, `get 'testfiles/porter.sbl'`, []string{"generalizations", "gener"})
+	for _, src := range []string{`get 'testfiles/nonexistent.sbl'`, `get stem`} {
+		if _, err := Parse(strings.NewReader(src)); err == nil {
+			t.Errorf("Parse(%q): expected error", src)
+		}
+	}
+	if _, err := parse(strings.NewReader(`get 'testfiles/porter.sbl'`), nil); err == nil {
+		t.Error("parse without open: expected error")
+	}
+}
+
+func TestRegionsUndeclared(t *testing.T) {
+	p := mustParse(t, `integers ( p1 ) externals ( stem ) define stem as ( hop 2 setmark p1 )`)
+	rs := p.Regions([]byte("añob"))
+	if len(rs) != 1 || string(rs["R1"]) != "ob" {
+		t.Errorf("Regions(%q) = %q; want only R1 ob", "añob", rs)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`externals ( stem )`,
+		`routines ( stem ) define stem as true`,
+		`externals ( stem ) define stem as x`,
+		`externals ( stem ) define stem as ( 'a'`,
+		`externals ( stem ) define stem as 'a`,
+		`externals ( stem ) define stem as [substring]`,
+		`externals ( stem ) define stem as among ( 'a' 'a' )`,
+		`integers ( x ) externals ( stem ) define stem as $x <- 1`,
+		`strings ( s ) externals ( stem ) define stem as $s`,
+		`externals ( stem stem ) define stem as true`,
+		`stringescapes {} externals ( stem ) define stem as '{x}'`,
+		`groupings ( v ) externals ( stem ) define stem as v`,
+		`externals ( stem ) define stem as true define stem as true`,
+	} {
+		if _, err := Parse(strings.NewReader(src)); err == nil {
+			t.Errorf("Parse(%q): expected error", src)
+		}
+	}
+}
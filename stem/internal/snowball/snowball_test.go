@@ -0,0 +1,109 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package snowball_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"gitlab.com/xojoc/util"
+	"xojoc.pw/nlp/stem/internal/porter2swedish"
+	"xojoc.pw/nlp/stem/internal/porterenglish"
+	"xojoc.pw/nlp/stem/internal/snowball"
+)
+
+func mustParse(file string) *snowball.Program {
+	f := util.MustOpen(file)
+	defer f.Close()
+	p, err := snowball.Parse(f)
+	util.Fatal(err)
+	return p
+}
+
+// testVocabulary compares p with the hand written stemmer fn on the
+// words of vocabulary at least min letters long.
+func testVocabulary(t *testing.T, p *snowball.Program, vocabulary string, min int, fn func(string) string) {
+	f := util.MustOpen(vocabulary)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		w := strings.Fields(scanner.Text())[0]
+		if len(w) < min {
+			continue
+		}
+		if s, want := p.StemString(w), fn(w); s != want {
+			t.Errorf("StemString(%q): expected %q got %q\n", w, want, s)
+		}
+	}
+	util.Fatal(scanner.Err())
+}
+
+// testOutput compares p with the stems listed in vocabulary.
+func testOutput(t *testing.T, p *snowball.Program, vocabulary string) {
+	f := util.MustOpen(vocabulary)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		words := strings.Fields(scanner.Text())
+		if len(words) != 2 {
+			continue
+		}
+		if s := p.StemString(words[0]); s != words[1] {
+			t.Errorf("StemString(%q): expected %q got %q\n", words[0], words[1], s)
+		}
+	}
+	util.Fatal(scanner.Err())
+}
+
+func TestPorter(t *testing.T) {
+	p := mustParse("testfiles/porter.sbl")
+	// the reference implementation doesn't stem words of one or two
+	// letters, porter.sbl does
+	testVocabulary(t, p, "../porterenglish/testfiles/vocabulary.txt", 3, porterenglish.StemString)
+	testVocabulary(t, p, "../porter2english/testfiles/vocabulary.txt", 3, porterenglish.StemString)
+}
+
+func TestSwedish(t *testing.T) {
+	p := mustParse("testfiles/swedish.sbl")
+	testVocabulary(t, p, "../porter2swedish/testfiles/vocabulary.txt", 0, porter2swedish.StemString)
+}
+
+func TestEnglish(t *testing.T) {
+	p := mustParse("testfiles/english.sbl")
+	testOutput(t, p, "../porter2english/testfiles/vocabulary.txt")
+}
+
+func TestSpanish(t *testing.T) {
+	p := mustParse("testfiles/spanish.sbl")
+	testOutput(t, p, "../porter2spanish/testfiles/vocabulary.txt")
+}
+
+func TestKraaijPohlmann(t *testing.T) {
+	p := mustParse("testfiles/kraaij_pohlmann.sbl")
+	testOutput(t, p, "../kraaijpohlmann/testfiles/vocabulary.txt")
+}
+
+func BenchmarkStemBytes(b *testing.B) {
+	p := mustParse("testfiles/porter.sbl")
+	words := [][]byte{[]byte("generalizations"), []byte("oscillators"), []byte("hopefulness")}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, w := range words {
+			_ = p.StemBytes(append([]byte(nil), w...))
+		}
+	}
+}
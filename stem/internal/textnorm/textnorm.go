@@ -0,0 +1,144 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Package textnorm implements the Unicode normalization shared by the
+// stemmers: composition (NFC), full case folding, apostrophe unification
+// and ligature expansion.
+package textnorm
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Form holds the language specific parts of the normalization.
+type Form struct {
+	// Letters are the ligatures which are letters of the language and
+	// must not be expanded, like æ in Danish.
+	Letters string
+}
+
+// apostrophes are unified to '.
+const apostrophes = "’‘‛ʼ"
+
+// ligatures not already expanded by case folding (ﬁ, ﬂ, ...).
+var ligatures = map[rune]string{
+	'ĳ': "ij",
+	'æ': "ae",
+	'œ': "oe",
+}
+
+// Bytes normalizes b with the default Form.
+func Bytes(b []byte) []byte {
+	return Form{}.Bytes(b)
+}
+
+// String normalizes s with the default Form.
+func String(s string) string {
+	return string(Bytes([]byte(s)))
+}
+
+// Bytes trims the white space around b and returns it composed, case
+// folded and with apostrophes and ligatures replaced. ASCII input is
+// lowered in place, otherwise a new slice is returned.
+func (f Form) Bytes(b []byte) []byte {
+	b = bytes.TrimSpace(b)
+	if isASCII(b) {
+		for i, c := range b {
+			if 'A' <= c && c <= 'Z' {
+				b[i] = c + 'a' - 'A'
+			}
+		}
+		return b
+	}
+	// NFC first so that decomposed letters are folded as a whole, then
+	// again since folding may leave combining marks around.
+	b = norm.NFC.Bytes(b)
+	b = cases.Fold().Bytes(b)
+	b = norm.NFC.Bytes(b)
+	out := b[:0:0]
+	for i := 0; i < len(b); {
+		r, l := utf8.DecodeRune(b[i:])
+		switch {
+		case strings.ContainsRune(apostrophes, r):
+			out = append(out, '\'')
+		case ligatures[r] != "" && !strings.ContainsRune(f.Letters, r):
+			out = append(out, ligatures[r]...)
+		default:
+			out = append(out, b[i:i+l]...)
+		}
+		i += l
+	}
+	return out
+}
+
+// String normalizes s with f.
+func (f Form) String(s string) string {
+	return string(f.Bytes([]byte(s)))
+}
+
+// bases maps the letters without a canonical decomposition to their base
+// letters. No base is longer than its letter, so they are replaced in place.
+var bases = map[rune]string{
+	'ø': "o",
+	'đ': "d",
+	'ł': "l",
+	'ħ': "h",
+	'ı': "i",
+	'æ': "ae",
+	'œ': "oe",
+}
+
+// FoldDiacritics removes the diacritics from the letters of b, which
+// must already be normalized: è becomes e, ø becomes o and so on.
+// ASCII input is returned as is, otherwise a new slice is returned.
+func FoldDiacritics(b []byte) []byte {
+	if isASCII(b) {
+		return b
+	}
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.Bytes(t, b)
+	if err != nil {
+		return b
+	}
+	b = folded
+	out := b[:0]
+	for i := 0; i < len(b); {
+		r, l := utf8.DecodeRune(b[i:])
+		if s, ok := bases[r]; ok {
+			out = append(out, s...)
+		} else {
+			out = append(out, b[i:i+l]...)
+		}
+		i += l
+	}
+	return out
+}
+
+func isASCII(b []byte) bool {
+	for _, c := range b {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package textnorm_test
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+)
+
+func TestBytes(t *testing.T) {
+	for in, out := range map[string]string{
+		"  NoRmAlIzEd  ": "normalized",
+		"citta\u0300":    "città",
+		"CITTÀ":          "città",
+		"dog’s":          "dog's",
+		"‘tis":           "'tis",
+		"l‛acqua":        "l'acqua",
+		"ﬁnal ﬂow":       "final flow",
+		"Straße":         "strasse",
+		"Ĳsselmeer":      "ijsselmeer",
+		"encyclopædia":   "encyclopaedia",
+		"ŒUVRE":          "oeuvre",
+		"ΣΑΣ":            "σασ",
+		"\t Ünïcödé ":    "ünïcödé",
+	} {
+		if got := textnorm.String(in); got != out {
+			t.Errorf("String(%q) = %q; want %q", in, got, out)
+		}
+	}
+}
+
+func TestFormLetters(t *testing.T) {
+	f := textnorm.Form{Letters: "æ"}
+	if got := f.String("KÆRLIGHEDŒ"); got != "kærlighedoe" {
+		t.Errorf("String(%q) = %q; want %q", "KÆRLIGHEDŒ", got, "kærlighedoe")
+	}
+}
+
+func TestASCIIInPlace(t *testing.T) {
+	b := []byte(" Hello ")
+	got := textnorm.Bytes(b)
+	if string(got) != "hello" || &got[0] != &b[1] {
+		t.Errorf("Bytes(%q) = %q, not in place", " Hello ", got)
+	}
+}
+
+func TestFoldDiacritics(t *testing.T) {
+	for in, out := range map[string]string{
+		"citta":        "citta",
+		"città":        "citta",
+		"crème brûlée": "creme brulee",
+		"søren":        "soren",
+		"łódź":         "lodz",
+		"kærlighed":    "kaerlighed",
+		"ñandú":        "nandu",
+		"ёлка":         "елка",
+	} {
+		if got := string(textnorm.FoldDiacritics([]byte(in))); got != out {
+			t.Errorf("FoldDiacritics(%q) = %q; want %q", in, got, out)
+		}
+	}
+}
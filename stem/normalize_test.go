@@ -0,0 +1,68 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem_test
+
+import (
+	"testing"
+
+	"xojoc.pw/nlp/stem"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		st   stem.Interface
+		in   string
+		norm string
+		stem string
+	}{
+		{stem.Porter2English{}, " Dog’s ", "dog's", "dog"},
+		{stem.Porter2English{}, "‘TIS", "'tis", "tis"},
+		{stem.Porter2English{}, "ﬁshing", "fishing", "fish"},
+		{stem.Porter2English{}, "Encyclopædia", "encyclopaedia", "encyclopaedia"},
+		{stem.Porter2Italian{}, "velocità", "velocità", "veloc"},
+		{stem.Porter2Italian{}, "PERCHÉ", "perché", "perc"},
+		{stem.Porter2French{}, "l’éléphant", "l'éléphant", "l'éleph"},
+		{stem.Porter2French{}, "Cœur", "cœur", "cœur"},
+		{stem.Porter2French{}, "NŒUDS", "nœuds", "nœud"},
+		{stem.Porter2French{}, "œuvre", "œuvre", "œuvr"},
+		{stem.Porter2French{}, "Cæsar", "cæsar", "cæsar"},
+		{stem.Porter2German{}, "STRAẞE", "strasse", "strass"},
+		{stem.Porter2Danish{}, "KÆRLIGHED", "kærlighed", "kær"},
+		{stem.Porter2Dutch{}, "Ĳzeren", "ijzeren", "ijzer"},
+	}
+	for _, tt := range tests {
+		n := tt.st.NormalizeString(tt.in)
+		if n != tt.norm {
+			t.Errorf("%T.NormalizeString(%q) = %q; want %q", tt.st, tt.in, n, tt.norm)
+		}
+		if s := tt.st.StemString(n); s != tt.stem {
+			t.Errorf("%T.StemString(%q) = %q; want %q", tt.st, n, s, tt.stem)
+		}
+	}
+}
+
+func TestFoldDiacritics(t *testing.T) {
+	st := stem.FoldDiacritics(stem.Porter2French{})
+	for _, w := range []string{"éléphants", "elephants", "ÉLÉPHANTS"} {
+		if s := st.StemString(st.NormalizeString(w)); s != "eleph" {
+			t.Errorf("StemString(%q) = %q; want %q", w, s, "eleph")
+		}
+	}
+	trs := stem.Explain(st, "éléphants")
+	if len(trs) == 0 || trs[len(trs)-1].Step != "foldDiacritics" || trs[len(trs)-1].Word != "eleph" {
+		t.Errorf("Explain(%q) = %v", "éléphants", trs)
+	}
+}
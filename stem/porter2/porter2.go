@@ -0,0 +1,321 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Package porter2 is the framework the Porter2 (Snowball) stemmers of
+// package stem are written with. It can be used to write custom
+// stemmers, for example to strip domain specific suffixes.
+//
+// A stemmer is a pipeline of steps. Each Step is a set of suffixes: when
+// applied to a word it looks for the longest suffix the word ends with
+// and calls the Callback associated with it. Regions, like R1 and R2,
+// restrict where a suffix may match. Use stem.Steps to turn a pipeline of
+// steps into a stem.Interface.
+package porter2
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+type action struct {
+	Suffix       []byte
+	Callback     func([]byte, []byte) []byte
+	MatchRegion  func([]byte) []byte
+	ActionRegion func([]byte) []byte
+	Region       string
+	Step         *Step
+}
+
+// trie is the trie of the reversed suffixes of a Step. The children of
+// a node are the nodes edges[base:base+n], indexed by label minus lo:
+// a table only spans from the lowest to the highest label in use, which
+// for most nodes are few and close, so the trie is small, and following
+// an edge is a single index. Missing children are zero nodes, which have
+// no children and no suffix.
+type trie struct {
+	root  node
+	edges []node
+}
+
+type node struct {
+	lo             byte
+	n              uint16
+	base           int32
+	suffixCallback *action
+}
+
+// reversedSuffix is a suffix spelled backwards.
+type reversedSuffix struct {
+	s  []byte
+	cb *action
+}
+
+func reverse(a []byte) []byte {
+	b := make([]byte, len(a))
+	for i, x := range a {
+		b[len(b)-i-1] = x
+	}
+	return b
+}
+
+func newTrie(suffixes []*action) trie {
+	rs := make([]reversedSuffix, len(suffixes))
+	for i, cb := range suffixes {
+		rs[i] = reversedSuffix{reverse(cb.Suffix), cb}
+	}
+	sort.Slice(rs, func(i, j int) bool { return bytes.Compare(rs[i].s, rs[j].s) < 0 })
+	var t trie
+	t.root = t.build(rs, 0)
+	return t
+}
+
+// build returns the node of the sorted suffixes rs, which all share
+// their first d bytes, after adding the nodes below it to t.edges.
+func (t *trie) build(rs []reversedSuffix, d int) node {
+	var n node
+	if len(rs) > 0 && len(rs[0].s) == d {
+		n.suffixCallback = rs[0].cb
+		rs = rs[1:]
+	}
+	if len(rs) == 0 {
+		return n
+	}
+	lo, hi := rs[0].s[d], rs[len(rs)-1].s[d]
+	n.lo, n.n, n.base = lo, uint16(hi-lo)+1, int32(len(t.edges))
+	t.edges = append(t.edges, make([]node, n.n)...)
+	for len(rs) > 0 {
+		b := rs[0].s[d]
+		j := 1
+		for j < len(rs) && rs[j].s[d] == b {
+			j++
+		}
+		t.edges[int(n.base)+int(b-lo)] = t.build(rs[:j], d+1)
+		rs = rs[j:]
+	}
+	return n
+}
+
+// Suffix describes a group of suffixes of a Step sharing the same action.
+type Suffix struct {
+	// Suffixes is the space separated list of suffixes.
+	Suffixes string
+	// Callback is called with the word and the matched suffix and
+	// returns the new word. It may return nil to leave the word
+	// unchanged. See Delete, Truncate and Replace.
+	Callback func(word []byte, suffix []byte) []byte
+	// MatchRegion, if not nil, returns the region of the word the
+	// suffix must be in to be considered at all.
+	MatchRegion func([]byte) []byte
+	// ActionRegion, if not nil, returns the region of the word the
+	// suffix must be in for Callback to be called. If the longest
+	// suffix isn't in this region the word is left unchanged.
+	ActionRegion func([]byte) []byte
+	// Region is the name of ActionRegion, or of MatchRegion if
+	// ActionRegion is nil, like "R1". It's only used by Match and
+	// Tracer.
+	Region string
+	// SuffixCallbacks, if not empty, is a nested step applied to the
+	// word returned by Callback.
+	SuffixCallbacks []Suffix
+}
+
+// Step is a set of suffixes compiled by NewStep. A nil Step leaves words
+// unchanged. It's safe for concurrent use, except for SetDo.
+type Step struct {
+	suffixes trie
+	do       func([]byte) ([]byte, bool)
+	// tracer, if not nil, records the actions of the step. See
+	// Tracer.Hook.
+	tracer *Tracer
+}
+
+// NewStep compiles suffixes into a Step. It returns an error if a suffix
+// is defined more than once, if a Suffix has no suffixes or if its
+// Callback is nil.
+func NewStep(suffixes []Suffix) (*Step, error) {
+	if len(suffixes) == 0 {
+		return nil, nil
+	}
+	var callbacks []*action
+	seen := map[string]bool{}
+	for _, x := range suffixes {
+		fields := strings.Fields(x.Suffixes)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("porter2: no suffixes in %q", x.Suffixes)
+		}
+		if x.Callback == nil {
+			return nil, fmt.Errorf("porter2: nil Callback for %q", x.Suffixes)
+		}
+		nested, err := NewStep(x.SuffixCallbacks)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			if seen[f] {
+				return nil, fmt.Errorf("porter2: suffix %q defined more than once", f)
+			}
+			seen[f] = true
+			c := action{[]byte(f), x.Callback, x.MatchRegion, x.ActionRegion, x.Region, nested}
+			callbacks = append(callbacks, &c)
+		}
+	}
+	return &Step{suffixes: newTrie(callbacks)}, nil
+}
+
+// MustNewStep is like NewStep but panics if the suffixes can't be
+// compiled. It simplifies the initialization of global variables.
+func MustNewStep(suffixes []Suffix) *Step {
+	s, err := NewStep(suffixes)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Apply applies the step to str and returns the result. See Do.
+func (s *Step) Apply(str []byte) []byte {
+	str, _ = s.Do(str)
+	return str
+}
+
+// Do is like Apply but also reports whether the action of the longest
+// matching suffix was performed. The nested steps of a suffix are only
+// applied after its action. A Callback can refuse a suffix by returning
+// nil, in which case str is returned unchanged.
+func (s *Step) Do(str []byte) ([]byte, bool) {
+	if s != nil && s.do != nil {
+		return s.do(str)
+	}
+	return s.Interpret(str)
+}
+
+// SetDo makes Do call do instead of walking the suffixes of the step.
+// do is usually generated from the suffixes by porter2gen and must
+// behave exactly like Interpret. A nil do restores the default.
+// SetDo isn't safe for concurrent use with the other methods: steps
+// are shared by every call to the stemmer, so it should only be called
+// from an init function, before the step is used.
+func (s *Step) SetDo(do func([]byte) ([]byte, bool)) {
+	s.do = do
+}
+
+// Interpret is like Do but always walks the suffixes of the step, even
+// if SetDo was called.
+func (s *Step) Interpret(str []byte) ([]byte, bool) {
+	suffix := s.find(str)
+	if suffix == nil {
+		return str, false
+	}
+	if s.tracer == nil {
+		r := suffix.Callback(str, suffix.Suffix)
+		if r == nil {
+			return str, false
+		}
+		return suffix.Step.Apply(r), true
+	}
+	// The Callback may change str in place, so any change made to the
+	// word before the step is recorded first.
+	s.tracer.removed(str)
+	r := suffix.Callback(str, suffix.Suffix)
+	if r == nil {
+		return str, false
+	}
+	s.tracer.change(r, string(suffix.Suffix), suffix.Region)
+	return s.tracer.Hook(suffix.Step).Apply(r), true
+}
+
+// Match returns the suffix of str the step would act upon and the name
+// of its Region. It doesn't call the Callback, so the step may still
+// refuse the suffix.
+func (s *Step) Match(str []byte) (suffix string, region string, ok bool) {
+	a := s.find(str)
+	if a == nil {
+		return "", "", false
+	}
+	return string(a.Suffix), a.Region, true
+}
+
+// find returns the longest suffix of str in its match region, or nil if
+// there's none or if it isn't in its action region.
+func (s *Step) find(str []byte) *action {
+	if s == nil {
+		return nil
+	}
+	var suffix *action
+	n, edges := &s.suffixes.root, s.suffixes.edges
+	for i := len(str) - 1; i >= 0; i-- {
+		j := str[i] - n.lo
+		if uint16(j) >= n.n {
+			break
+		}
+		n = &edges[int(n.base)+int(j)]
+		sx := n.suffixCallback
+		if sx != nil {
+			if sx.MatchRegion == nil || bytes.HasSuffix(sx.MatchRegion(str), sx.Suffix) {
+				suffix = sx
+			}
+		}
+	}
+	if suffix == nil {
+		return nil
+	}
+	if suffix.ActionRegion != nil && !bytes.HasSuffix(suffix.ActionRegion(str), suffix.Suffix) {
+		return nil
+	}
+	return suffix
+}
+
+// Common callbacks.
+
+// Delete removes the suffix.
+func Delete(s []byte, suffix []byte) []byte {
+	return s[:len(s)-len(suffix)]
+}
+
+// Truncate keeps the first len(to) bytes of the suffix. to must be a
+// prefix of all the suffixes it's used with.
+func Truncate(to string) func([]byte, []byte) []byte {
+	return func(s []byte, suffix []byte) []byte {
+		return s[:len(s)-len(suffix)+len(to)]
+	}
+}
+
+// Replace replaces the suffix with by.
+func Replace(by string) func(s []byte, suffix []byte) []byte {
+	return func(s []byte, suffix []byte) []byte {
+		s = s[:len(s)-len(suffix)]
+		return append(s, by...)
+	}
+}
+
+// MapRunes replaces each rune of s with mapping(r) in place and returns
+// the modified slice. mapping must never return a rune whose UTF-8
+// encoding is longer than the one it replaces.
+func MapRunes(s []byte, mapping func(r rune) rune) []byte {
+	j := 0
+	for i := 0; i < len(s); {
+		r, l := utf8.DecodeRune(s[i:])
+		if m := mapping(r); m != r {
+			j += utf8.EncodeRune(s[j:], m)
+		} else {
+			j += copy(s[j:], s[i:i+l])
+		}
+		i += l
+	}
+	return s[:j]
+}
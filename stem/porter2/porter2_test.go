@@ -0,0 +1,217 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewStep(t *testing.T) {
+	for _, suffixes := range [][]Suffix{
+		{{Suffixes: "a b", Callback: Delete}, {Suffixes: "c b", Callback: Delete}},
+		{{Suffixes: "a a", Callback: Delete}},
+		{{Suffixes: " ", Callback: Delete}},
+		{{Suffixes: "a"}},
+		{{Suffixes: "a", Callback: Delete, SuffixCallbacks: []Suffix{{Suffixes: "b"}}}},
+	} {
+		if _, err := NewStep(suffixes); err == nil {
+			t.Errorf("NewStep(%v): expected error", suffixes)
+		}
+	}
+	// The same suffix can be used by a nested step.
+	_, err := NewStep([]Suffix{
+		{Suffixes: "ab", Callback: Delete, SuffixCallbacks: []Suffix{{Suffixes: "ab", Callback: Delete}}},
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDo(t *testing.T) {
+	r1 := R1("aeiou")
+	step := MustNewStep([]Suffix{
+		{Suffixes: "s", Callback: Delete},
+		{Suffixes: "ies", Callback: Replace("y"), MatchRegion: r1},
+		{Suffixes: "ness", Callback: Delete, ActionRegion: r1},
+		{Suffixes: "ful", Callback: func(s, suffix []byte) []byte { return nil }},
+		{Suffixes: "ingly", Callback: Truncate("ing")},
+		{Suffixes: "ations", Callback: Replace("ate"), SuffixCallbacks: []Suffix{
+			{Suffixes: "ate", Callback: Delete, MatchRegion: r1},
+		}},
+	})
+	for _, c := range []struct {
+		in, out string
+		ok      bool
+	}{
+		{"cats", "cat", true},
+		{"ponies", "pony", true},
+		{"dies", "die", true},
+		{"kindness", "kind", true},
+		{"ness", "ness", false},
+		{"hopeful", "hopeful", false},
+		{"relations", "rel", true},
+		{"rations", "rate", true},
+		{"knowingly", "knowing", true},
+		{"xyz", "xyz", false},
+		{"", "", false},
+	} {
+		out, ok := step.Do([]byte(c.in))
+		if string(out) != c.out || ok != c.ok {
+			t.Errorf("Do(%q) = %q, %v; want %q, %v", c.in, out, ok, c.out, c.ok)
+		}
+	}
+	var nilStep *Step
+	if out, ok := nilStep.Do([]byte("cats")); string(out) != "cats" || ok {
+		t.Errorf("nil Step changed the word: %q, %v", out, ok)
+	}
+}
+
+func TestRegions(t *testing.T) {
+	const vowels = "aeiouy"
+	r1, r2 := R1(vowels), R2(vowels)
+	for _, c := range []struct{ word, r1, r2 string }{
+		{"beautiful", "iful", "ul"},
+		{"beauty", "y", ""},
+		{"beau", "", ""},
+		{"animadversion", "imadversion", "adversion"},
+		{"sprinkled", "kled", ""},
+		{"eucharist", "harist", "ist"},
+	} {
+		if got := string(r1([]byte(c.word))); got != c.r1 {
+			t.Errorf("R1(%q) = %q; want %q", c.word, got, c.r1)
+		}
+		if got := string(r2([]byte(c.word))); got != c.r2 {
+			t.Errorf("R2(%q) = %q; want %q", c.word, got, c.r2)
+		}
+	}
+}
+
+func TestTracer(t *testing.T) {
+	step := MustNewStep([]Suffix{
+		{Suffixes: "ies", Callback: Replace("y"), MatchRegion: R1("aeiouy"), Region: "R1"},
+	})
+	tr := NewTracer([]byte("ponies"))
+	tr.Record("unchanged", []byte("ponies"))
+	tr.Record("plural", tr.Hook(step).Apply([]byte("ponies")))
+	tr.Record("strip", []byte("pon"))
+	tr.Record("respell", []byte("pun"))
+	want := []Trace{
+		{"plural", "ies", "R1", "pony"},
+		{"strip", "y", "", "pon"},
+		{"respell", "", "", "pun"},
+	}
+	if len(tr.Traces) != len(want) {
+		t.Fatalf("Traces = %+v; want %+v", tr.Traces, want)
+	}
+	for i := range want {
+		if tr.Traces[i] != want[i] {
+			t.Errorf("Traces[%d] = %+v; want %+v", i, tr.Traces[i], want[i])
+		}
+	}
+	var nilTracer *Tracer
+	if nilTracer.Hook(step) != step {
+		t.Errorf("a nil Tracer hooked the step")
+	}
+	nilTracer.Record("plural", []byte("pony"))
+}
+
+func TestTracerNested(t *testing.T) {
+	step := MustNewStep([]Suffix{
+		{Suffixes: "ement", Callback: Delete, Region: "RV", SuffixCallbacks: []Suffix{
+			{Suffixes: "eus", Callback: Delete, Region: "R2", SuffixCallbacks: []Suffix{
+				{Suffixes: "u", Callback: Replace("ue")},
+			}},
+		}},
+	})
+	tr := NewTracer([]byte("majestueusement"))
+	w := tr.Hook(step).Apply([]byte("majestueusement"))
+	tr.Record("step1", w)
+	want := []Trace{
+		{"step1", "ement", "RV", "majestueus"},
+		{"step1", "eus", "R2", "majestu"},
+		{"step1", "u", "", "majestue"},
+	}
+	if !reflect.DeepEqual(tr.Traces, want) {
+		t.Errorf("Traces = %+v; want %+v", tr.Traces, want)
+	}
+}
+
+const italianVerbs = "ammo ando ano are arono asse assero assi assimo ata ate ati ato ava avamo avano avate avi avo emmo enda ende endi endo erà erai eranno ere erebbe erebbero erei eremmo eremo ereste eresti erete erò erono essero ete eva evamo evano evate evi evo Yamo iamo immo irà irai iranno ire irebbe irebbero irei iremmo iremo ireste iresti irete irò irono isca iscano isce isci isco iscono issero ita ite iti ito iva ivamo ivano ivate ivi ivo ono uta ute uti uto ar ir"
+
+func BenchmarkNewStep(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		MustNewStep([]Suffix{{Suffixes: italianVerbs, Callback: Delete}})
+	}
+}
+
+func BenchmarkDo(b *testing.B) {
+	step := MustNewStep([]Suffix{{Suffixes: italianVerbs, Callback: Delete}})
+	words := [][]byte{[]byte("parlerebbero"), []byte("finiscono"), []byte("casa"), []byte("amavamo")}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, w := range words {
+			step.Do(w[:len(w):len(w)])
+		}
+	}
+}
+
+func TestFullByteRange(t *testing.T) {
+	step := MustNewStep([]Suffix{
+		{Suffixes: "\xff \x00\xff", Callback: Delete},
+		{Suffixes: "\xfe", Callback: Replace("\xff")},
+	})
+	for in, out := range map[string]string{
+		"a\xff":     "a",
+		"a\x00\xff": "a",
+		"a\xfe":     "a\xff",
+		"\xff\xff":  "\xff",
+	} {
+		if got := string(step.Apply([]byte(in))); got != out {
+			t.Errorf("Apply(%q) = %q; want %q", in, got, out)
+		}
+	}
+}
+
+func TestMapRunes(t *testing.T) {
+	fold := func(r rune) rune {
+		switch r {
+		case 'ü', 'U':
+			return 'u'
+		case 'ä':
+			return 'a'
+		}
+		return r
+	}
+	for in, out := range map[string]string{
+		"":          "",
+		"über":      "uber",
+		"mädchen":   "madchen",
+		"UÜ\xffü":   "uÜ\xffu",
+		"кот":       "кот",
+		"aäüaäüaäü": "aauaauaau",
+	} {
+		b := []byte(in)
+		got := MapRunes(b, fold)
+		if string(got) != out {
+			t.Errorf("MapRunes(%q) = %q; want %q", in, got, out)
+		}
+		if len(got) > 0 && &got[0] != &b[0] {
+			t.Errorf("MapRunes(%q) did not work in place", in)
+		}
+	}
+}
@@ -0,0 +1,730 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Command porter2gen compiles the steps of a stemmer written with package
+// porter2 into Go code.
+//
+// It reads the Go files of the package in the current directory and looks
+// for the package level variables initialized with MustNewStep from a
+// []Suffix literal. For each of them it writes to steps_gen.go a function
+// matching the suffixes with switch statements, which is installed with
+// Step.SetDo. If the package has a testfiles/vocabulary.txt file, it also
+// writes steps_gen_test.go, checking that the generated functions and the
+// interpreted steps agree on all the words of the vocabulary.
+//
+// porter2gen is meant to be run by go generate:
+//
+//	//go:generate go run ../../porter2/porter2gen/main.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	genFile     = "steps_gen.go"
+	genTestFile = "steps_gen_test.go"
+	vocabulary  = "testfiles/vocabulary.txt"
+)
+
+// pkg is the package being compiled.
+type pkg struct {
+	fset  *token.FileSet
+	name  string
+	files []*ast.File
+	// decls are the package level declarations.
+	decls map[string]ast.Expr
+	// imports are the imports needed by the expressions copied from the
+	// source.
+	imports map[string]*ast.ImportSpec
+	// dotImports are the dot imports of the package.
+	dotImports []*ast.ImportSpec
+}
+
+// suffix is a single suffix of a []Suffix.
+type suffix struct {
+	text         string
+	callback     ast.Expr
+	matchRegion  ast.Expr
+	actionRegion ast.Expr
+	nested       *list
+}
+
+// list is a compiled []Suffix.
+type list struct {
+	name     string
+	suffixes []*suffix
+}
+
+// step is a package level Step variable.
+type step struct {
+	name string
+	list *list
+}
+
+type generator struct {
+	p      *pkg
+	lists  map[string]*list
+	order  []*list
+	steps  []*step
+	vars   bytes.Buffer
+	funcs  bytes.Buffer
+	hoists map[string][]string
+	// usesBytes reports whether the generated code uses package bytes.
+	usesBytes bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("porter2gen: ")
+	p, err := parsePackage(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &generator{p: p, lists: map[string]*list{}, hoists: map[string][]string{}}
+	if err := g.collect(); err != nil {
+		log.Fatal(err)
+	}
+	if len(g.steps) == 0 {
+		log.Fatal("no steps found")
+	}
+	src, err := g.generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(genFile, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := os.Stat(vocabulary); err != nil {
+		return
+	}
+	src, err = g.generateTest()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(genTestFile, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parsePackage(dir string) (*pkg, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	p := &pkg{fset: token.NewFileSet(), decls: map[string]ast.Expr{}, imports: map[string]*ast.ImportSpec{}}
+	for _, info := range infos {
+		name := info.Name()
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == genFile {
+			continue
+		}
+		f, err := parser.ParseFile(p.fset, name, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if hasBuildTag(f) {
+			continue
+		}
+		if p.name == "" {
+			p.name = f.Name.Name
+		}
+		p.files = append(p.files, f)
+		for _, d := range f.Decls {
+			switch d := d.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil {
+					p.decls[d.Name.Name] = nil
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					switch spec := spec.(type) {
+					case *ast.ValueSpec:
+						for i, n := range spec.Names {
+							var v ast.Expr
+							if i < len(spec.Values) {
+								v = spec.Values[i]
+							}
+							p.decls[n.Name] = v
+						}
+					case *ast.TypeSpec:
+						p.decls[spec.Name.Name] = nil
+					}
+				}
+			}
+		}
+		for _, imp := range f.Imports {
+			if imp.Name != nil && imp.Name.Name == "." {
+				p.dotImports = append(p.dotImports, imp)
+			}
+		}
+	}
+	if len(p.files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return p, nil
+}
+
+// hasBuildTag reports whether f is only built with some build tag, like
+// the gofuzz files.
+func hasBuildTag(f *ast.File) bool {
+	for _, cg := range f.Comments {
+		if cg.Pos() > f.Package {
+			break
+		}
+		for _, c := range cg.List {
+			if strings.HasPrefix(c.Text, "// +build") || strings.HasPrefix(c.Text, "//go:build") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *generator) errorf(n ast.Node, format string, args ...interface{}) error {
+	return fmt.Errorf("%s: %s", g.p.fset.Position(n.Pos()), fmt.Sprintf(format, args...))
+}
+
+// isFunc reports whether e is a call to the porter2 function name.
+func (g *generator) isFunc(e ast.Expr, name string) bool {
+	switch e := e.(type) {
+	case *ast.Ident:
+		_, local := g.p.decls[name]
+		return e.Name == name && !local
+	case *ast.SelectorExpr:
+		return e.Sel.Name == name
+	}
+	return false
+}
+
+func (g *generator) collect() error {
+	var names []string
+	for name, v := range g.p.decls {
+		call, ok := v.(*ast.CallExpr)
+		if ok && g.isFunc(call.Fun, "MustNewStep") && len(call.Args) == 1 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		call := g.p.decls[name].(*ast.CallExpr)
+		l, err := g.list(name, call.Args[0])
+		if err != nil {
+			return err
+		}
+		g.steps = append(g.steps, &step{name, l})
+	}
+	return nil
+}
+
+// list compiles the []Suffix literal e, named name.
+func (g *generator) list(name string, e ast.Expr) (*list, error) {
+	if id, ok := e.(*ast.Ident); ok {
+		if l, ok := g.lists[id.Name]; ok {
+			return l, nil
+		}
+		v, ok := g.p.decls[id.Name]
+		if !ok || v == nil {
+			return nil, g.errorf(e, "can't resolve %s", id.Name)
+		}
+		l, err := g.list(id.Name, v)
+		if err != nil {
+			return nil, err
+		}
+		g.lists[id.Name] = l
+		return l, nil
+	}
+	lit, ok := e.(*ast.CompositeLit)
+	if !ok {
+		return nil, g.errorf(e, "%s isn't a []Suffix literal", name)
+	}
+	l := &list{name: name}
+	seen := map[string]bool{}
+	for i, elt := range lit.Elts {
+		sl, ok := elt.(*ast.CompositeLit)
+		if !ok {
+			return nil, g.errorf(elt, "not a Suffix literal")
+		}
+		var text string
+		proto := suffix{}
+		for _, f := range sl.Elts {
+			kv, ok := f.(*ast.KeyValueExpr)
+			if !ok {
+				return nil, g.errorf(f, "Suffix literals must have keyed fields")
+			}
+			key := kv.Key.(*ast.Ident).Name
+			switch key {
+			case "Suffixes":
+				s, err := g.stringValue(kv.Value)
+				if err != nil {
+					return nil, err
+				}
+				text = s
+			case "Callback":
+				proto.callback = kv.Value
+			case "MatchRegion":
+				proto.matchRegion = kv.Value
+			case "ActionRegion":
+				proto.actionRegion = kv.Value
+			case "SuffixCallbacks":
+				nested, err := g.list(fmt.Sprintf("%sNested%d", name, i), kv.Value)
+				if err != nil {
+					return nil, err
+				}
+				proto.nested = nested
+			}
+		}
+		if proto.callback == nil {
+			return nil, g.errorf(sl, "nil Callback")
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			return nil, g.errorf(sl, "no suffixes")
+		}
+		for _, f := range fields {
+			if seen[f] {
+				return nil, g.errorf(sl, "suffix %q defined more than once", f)
+			}
+			seen[f] = true
+			s := proto
+			s.text = f
+			l.suffixes = append(l.suffixes, &s)
+		}
+	}
+	if _, ok := g.lists[name]; !ok {
+		g.order = append(g.order, l)
+	}
+	return l, nil
+}
+
+// stringValue returns the value of the string literal or constant e.
+func (g *generator) stringValue(e ast.Expr) (string, error) {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			return strconv.Unquote(e.Value)
+		}
+	case *ast.Ident:
+		if v, ok := g.p.decls[e.Name]; ok && v != nil {
+			return g.stringValue(v)
+		}
+	case *ast.ParenExpr:
+		return g.stringValue(e.X)
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			x, err := g.stringValue(e.X)
+			if err != nil {
+				return "", err
+			}
+			y, err := g.stringValue(e.Y)
+			return x + y, err
+		}
+	}
+	return "", g.errorf(e, "not a constant string")
+}
+
+// source returns the source code of e.
+func (g *generator) source(e ast.Expr) string {
+	var b bytes.Buffer
+	format.Node(&b, g.p.fset, e)
+	return b.String()
+}
+
+// hoist returns an expression referencing e, a function value, from the
+// variable named name. The imports e needs are recorded.
+func (g *generator) hoist(name string, e ast.Expr) string {
+	g.addImports(e)
+	src := g.source(e)
+	for i, s := range g.hoists[name] {
+		if s == src {
+			return fmt.Sprintf("%s[%d]", name, i)
+		}
+	}
+	g.hoists[name] = append(g.hoists[name], src)
+	return fmt.Sprintf("%s[%d]", name, len(g.hoists[name])-1)
+}
+
+var predeclared = map[string]bool{
+	"true": true, "false": true, "nil": true, "len": true, "append": true,
+	"string": true, "byte": true, "rune": true, "int": true,
+}
+
+func (g *generator) addImports(e ast.Expr) {
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok {
+				for _, f := range g.p.files {
+					for _, imp := range f.Imports {
+						path, _ := strconv.Unquote(imp.Path.Value)
+						name := path[strings.LastIndex(path, "/")+1:]
+						if imp.Name != nil {
+							name = imp.Name.Name
+						}
+						if name == x.Name {
+							g.p.imports[path] = imp
+						}
+					}
+				}
+			}
+			return false
+		case *ast.Ident:
+			if _, local := g.p.decls[n.Name]; !local && !predeclared[n.Name] {
+				for _, imp := range g.p.dotImports {
+					path, _ := strconv.Unquote(imp.Path.Value)
+					g.p.imports[path] = imp
+				}
+			}
+		}
+		return true
+	})
+}
+
+// region returns the code calling the region function e on s.
+func (g *generator) region(l *list, e ast.Expr) string {
+	if id, ok := e.(*ast.Ident); ok {
+		if _, local := g.p.decls[id.Name]; local {
+			return id.Name + "(s)"
+		}
+	}
+	return g.hoist(g.rootName(l)+"Regions", e) + "(s)"
+}
+
+// rootName returns the name of the step or list the variables of l are
+// named after.
+func (g *generator) rootName(l *list) string {
+	return l.name
+}
+
+// suffixVar returns the variable holding the []byte of s.
+func (g *generator) suffixVar(l *list, s *suffix) string {
+	name := l.name + "Suffixes"
+	for i, x := range g.hoists[name] {
+		if x == strconv.Quote(s.text) {
+			return fmt.Sprintf("%s[%d]", name, i)
+		}
+	}
+	g.hoists[name] = append(g.hoists[name], strconv.Quote(s.text))
+	return fmt.Sprintf("%s[%d]", name, len(g.hoists[name])-1)
+}
+
+// action returns the code performing the action of s on s, knowing that
+// the word ends with it.
+func (g *generator) action(l *list, s *suffix) string {
+	var b strings.Builder
+	n := len(s.text)
+	if s.actionRegion != nil {
+		g.usesBytes = true
+		fmt.Fprintf(&b, "if !bytes.HasSuffix(%s, %s) {\nreturn s, false\n}\n", g.region(l, s.actionRegion), g.suffixVar(l, s))
+	}
+	canRefuse := true
+	cb := s.callback
+	switch {
+	case g.isFunc(cb, "Delete"):
+		fmt.Fprintf(&b, "r := s[:len(s)-%d]\n", n)
+		canRefuse = false
+	default:
+		call, ok := cb.(*ast.CallExpr)
+		if ok && len(call.Args) == 1 {
+			arg, err := g.stringValue(call.Args[0])
+			if err == nil && g.isFunc(call.Fun, "Replace") {
+				fmt.Fprintf(&b, "r := append(s[:len(s)-%d], %s...)\n", n, strconv.Quote(arg))
+				canRefuse = false
+				break
+			}
+			if err == nil && g.isFunc(call.Fun, "Truncate") {
+				fmt.Fprintf(&b, "r := s[:len(s)-%d]\n", n-len(arg))
+				canRefuse = false
+				break
+			}
+		}
+		f := ""
+		if id, ok := cb.(*ast.Ident); ok {
+			if _, local := g.p.decls[id.Name]; local {
+				f = id.Name
+			}
+		}
+		if f == "" {
+			f = g.hoist(g.rootName(l)+"Callbacks", cb)
+		}
+		fmt.Fprintf(&b, "r := %s(s, %s)\n", f, g.suffixVar(l, s))
+	}
+	if canRefuse {
+		b.WriteString("if r == nil {\nreturn s, false\n}\n")
+	}
+	if s.nested != nil {
+		fmt.Fprintf(&b, "r, _ = %sDo(r)\n", s.nested.name)
+	}
+	b.WriteString("return r, true\n")
+	return b.String()
+}
+
+// trie is a trie of reversed suffixes.
+type trie struct {
+	labels []byte
+	leafs  map[byte]*trie
+	suffix *suffix
+}
+
+func (t *trie) insert(x string, s *suffix) {
+	if len(x) == 0 {
+		t.suffix = s
+		return
+	}
+	b := x[len(x)-1]
+	if t.leafs == nil {
+		t.leafs = map[byte]*trie{}
+	}
+	if t.leafs[b] == nil {
+		t.leafs[b] = &trie{}
+		t.labels = append(t.labels, b)
+		sort.Slice(t.labels, func(i, j int) bool { return t.labels[i] < t.labels[j] })
+	}
+	t.leafs[b].insert(x[:len(x)-1], s)
+}
+
+// byteLit returns b as a Go literal.
+func byteLit(b byte) string {
+	if b >= ' ' && b < 0x7f && b != '\\' && b != '\'' {
+		return "'" + string(b) + "'"
+	}
+	return fmt.Sprintf("0x%02x", b)
+}
+
+// node writes the code matching the suffixes in t, knowing the word ends
+// with the depth bytes leading to t. Longer suffixes are tried first and
+// the suffix of t, if any, only if none of them is in its match region.
+func (g *generator) node(l *list, t *trie, depth int) {
+	f := &g.funcs
+	if len(t.labels) > 0 {
+		fmt.Fprintf(f, "if len(s) > %d {\n", depth)
+		fmt.Fprintf(f, "switch s[len(s)-%d] {\n", depth+1)
+		for _, b := range t.labels {
+			fmt.Fprintf(f, "case %s:\n", byteLit(b))
+			g.node(l, t.leafs[b], depth+1)
+		}
+		f.WriteString("}\n}\n")
+	}
+	if s := t.suffix; s != nil {
+		if s.matchRegion != nil {
+			g.usesBytes = true
+			fmt.Fprintf(f, "if bytes.HasSuffix(%s, %s) {\n%s}\n", g.region(l, s.matchRegion), g.suffixVar(l, s), g.action(l, s))
+		} else {
+			f.WriteString(g.action(l, s))
+		}
+	}
+}
+
+// function writes the function matching the suffixes of l.
+func (g *generator) function(l *list) {
+	t := &trie{}
+	for _, s := range l.suffixes {
+		t.insert(s.text, s)
+	}
+	fmt.Fprintf(&g.funcs, "func %sDo(s []byte) ([]byte, bool) {\n", l.name)
+	g.node(l, t, 0)
+	g.funcs.WriteString("return s, false\n}\n\n")
+}
+
+func (g *generator) generate() ([]byte, error) {
+	for _, l := range g.order {
+		g.function(l)
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by porter2gen. DO NOT EDIT.\n\npackage %s\n\n", g.p.name)
+	b.WriteString("import (\n")
+	if g.usesBytes {
+		b.WriteString("\"bytes\"\n")
+	}
+	var paths []string
+	for path := range g.p.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		imp := g.p.imports[path]
+		if imp.Name != nil {
+			fmt.Fprintf(&b, "%s ", imp.Name.Name)
+		}
+		fmt.Fprintf(&b, "%q\n", path)
+	}
+	b.WriteString(")\n\n")
+	b.WriteString("func init() {\n")
+	for _, s := range g.steps {
+		fmt.Fprintf(&b, "%s.SetDo(%sDo)\n", s.name, s.list.name)
+	}
+	b.WriteString("}\n\n")
+	var names []string
+	for name := range g.hoists {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		typ := "func([]byte) []byte"
+		switch {
+		case strings.HasSuffix(name, "Callbacks"):
+			typ = "func([]byte, []byte) []byte"
+		case strings.HasSuffix(name, "Suffixes"):
+			typ = "[]byte"
+		}
+		fmt.Fprintf(&b, "var %s = [...]%s{\n", name, typ)
+		for _, x := range g.hoists[name] {
+			if typ == "[]byte" {
+				x = "[]byte(" + x + ")"
+			}
+			fmt.Fprintf(&b, "%s,\n", x)
+		}
+		b.WriteString("}\n\n")
+	}
+	b.Write(g.funcs.Bytes())
+	return g.format(b.Bytes())
+}
+
+func (g *generator) format(src []byte) ([]byte, error) {
+	out, err := format.Source(src)
+	if err != nil {
+		return nil, fmt.Errorf("%v\n%s", err, src)
+	}
+	return out, nil
+}
+
+func (g *generator) generateTest() ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `// Code generated by porter2gen. DO NOT EDIT.
+
+package %s
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+var generatedSteps = []struct {
+	name string
+	step interface {
+		Interpret([]byte) ([]byte, bool)
+		SetDo(func([]byte) ([]byte, bool))
+	}
+	do func([]byte) ([]byte, bool)
+}{
+`, g.p.name)
+	for _, s := range g.steps {
+		fmt.Fprintf(&b, "{%q, %s, %sDo},\n", s.name, s.name, s.list.name)
+	}
+	fmt.Fprintf(&b, `}
+
+func generatedVocabulary(tb testing.TB) []string {
+	f, err := os.Open(%q)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer f.Close()
+	var words []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			words = append(words, fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return words
+}
+
+// TestGeneratedSteps checks that the generated steps and the interpreted
+// ones agree on all the prefixes of the words of the vocabulary.
+func TestGeneratedSteps(t *testing.T) {
+	words := generatedVocabulary(t)
+	for _, st := range generatedSteps {
+		for _, w := range words {
+			for i := 1; i <= len(w); i++ {
+				want, wantOK := st.step.Interpret([]byte(w[:i]))
+				got, gotOK := st.do([]byte(w[:i]))
+				if string(got) != string(want) || gotOK != wantOK {
+					t.Errorf("%%s(%%q) = %%q, %%v; want %%q, %%v", st.name, w[:i], got, gotOK, want, wantOK)
+				}
+			}
+		}
+	}
+}
+`, vocabulary)
+	if _, ok := g.p.decls["StemBytes"]; ok {
+		b.WriteString(`
+// TestGeneratedStemBytes checks that the stemmer gives the same stems
+// with the generated steps and with the interpreted ones.
+func TestGeneratedStemBytes(t *testing.T) {
+	words := generatedVocabulary(t)
+	stems := make([]string, len(words))
+	for i, w := range words {
+		stems[i] = string(StemBytes([]byte(w)))
+	}
+	for _, st := range generatedSteps {
+		st.step.SetDo(nil)
+	}
+	defer func() {
+		for _, st := range generatedSteps {
+			st.step.SetDo(st.do)
+		}
+	}()
+	for i, w := range words {
+		if s := string(StemBytes([]byte(w))); s != stems[i] {
+			t.Errorf("StemBytes(%q) = %q interpreted, %q generated", w, s, stems[i])
+		}
+	}
+}
+
+func benchmarkStemBytes(b *testing.B) {
+	words := generatedVocabulary(b)
+	buf := make([]byte, 0, 64)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, w := range words {
+			StemBytes(append(buf[:0], w...))
+		}
+	}
+}
+
+func BenchmarkGeneratedStemBytes(b *testing.B) {
+	benchmarkStemBytes(b)
+}
+
+func BenchmarkInterpretedStemBytes(b *testing.B) {
+	for _, st := range generatedSteps {
+		st.step.SetDo(nil)
+	}
+	defer func() {
+		for _, st := range generatedSteps {
+			st.step.SetDo(st.do)
+		}
+	}()
+	benchmarkStemBytes(b)
+}
+`)
+	}
+	return g.format(b.Bytes())
+}
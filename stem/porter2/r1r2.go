@@ -0,0 +1,187 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+)
+
+// http://snowballstem.org/texts/r1r2.html
+
+func isVowel(r rune, vowels string) bool {
+	return strings.ContainsRune(vowels, r)
+}
+
+func isConsonant(r rune, vowels string) bool {
+	return !isVowel(r, vowels)
+}
+
+// R1 returns a function returning the R1 region of a word: the part of
+// the word after the first consonant following a vowel.
+func R1(vowels string) func([]byte) []byte {
+	return func(s []byte) []byte {
+		for {
+			r, l := utf8.DecodeRune(s)
+			if l == 0 || isVowel(r, vowels) {
+				break
+			}
+			s = s[l:]
+		}
+		for {
+			r, l := utf8.DecodeRune(s)
+			if l == 0 || isConsonant(r, vowels) {
+				break
+			}
+			s = s[l:]
+		}
+		_, l := utf8.DecodeRune(s)
+		return s[l:]
+	}
+}
+
+// R2 returns a function returning the R2 region of a word: the R1
+// region of R1.
+func R2(vowels string) func([]byte) []byte {
+	r1 := R1(vowels)
+	return func(s []byte) []byte {
+		return r1(r1(s))
+	}
+}
+
+// ScandinavianR1 is R1 adjusted so that the region before it contains at
+// least 3 letters. It is used by the German and Scandinavian stemmers.
+func ScandinavianR1(vowels string) func([]byte) []byte {
+	r1 := R1(vowels)
+	return func(s []byte) []byte {
+		i := 0
+		for n := 0; n < 3; n++ {
+			_, l := utf8.DecodeRune(s[i:])
+			i += l
+		}
+		r := r1(s)
+		if len(s)-len(r) < i {
+			return s[i:]
+		}
+		return r
+	}
+}
+
+// http://snowballstem.org/algorithms/spanish/stemmer.html
+
+// SpanishRV returns a function returning the RV region of a word as
+// defined by the Spanish, Portuguese and Italian stemmers.
+func SpanishRV(vowels string) func([]byte) []byte {
+	return func(s []byte) []byte {
+		r1, l := utf8.DecodeRune(s)
+		s = s[l:]
+		r2, l := utf8.DecodeRune(s)
+		if r2 == utf8.RuneError {
+			return s[len(s):]
+		}
+		s = s[l:]
+
+		if isConsonant(r2, vowels) {
+			for {
+				r, l := utf8.DecodeRune(s)
+				if l == 0 {
+					break
+				}
+				s = s[l:]
+				if isVowel(r, vowels) {
+					break
+				}
+			}
+			return s
+		}
+
+		if isVowel(r1, vowels) && isVowel(r2, vowels) {
+			for {
+				r, l := utf8.DecodeRune(s)
+				if l == 0 {
+					break
+				}
+				s = s[l:]
+				if isConsonant(r, vowels) {
+					break
+				}
+			}
+			return s
+		}
+
+		_, l = utf8.DecodeRune(s)
+		return s[l:]
+	}
+}
+
+// http://snowballstem.org/algorithms/french/stemmer.html
+
+var frenchRVPrefixes = []string{"par", "col", "tap"}
+
+// FrenchRV returns a function returning the RV region of a word as
+// defined by the French stemmer.
+func FrenchRV(vowels string) func([]byte) []byte {
+	return func(s []byte) []byte {
+		for _, p := range frenchRVPrefixes {
+			if bytes.HasPrefix(s, []byte(p)) {
+				return s[len(p):]
+			}
+		}
+
+		r1, l1 := utf8.DecodeRune(s)
+		r2, l2 := utf8.DecodeRune(s[l1:])
+		if isVowel(r1, vowels) && isVowel(r2, vowels) {
+			_, l3 := utf8.DecodeRune(s[l1+l2:])
+			if l3 > 0 {
+				return s[l1+l2+l3:]
+			}
+		}
+
+		s = s[l1:]
+		for {
+			r, l := utf8.DecodeRune(s)
+			if l == 0 {
+				break
+			}
+			s = s[l:]
+			if isVowel(r, vowels) {
+				break
+			}
+		}
+		return s
+	}
+}
+
+// http://snowballstem.org/algorithms/russian/stemmer.html
+
+// RussianRV returns a function returning the RV region of a word: the
+// part of the word after the first vowel.
+func RussianRV(vowels string) func([]byte) []byte {
+	return func(s []byte) []byte {
+		for {
+			r, l := utf8.DecodeRune(s)
+			if l == 0 {
+				break
+			}
+			s = s[l:]
+			if isVowel(r, vowels) {
+				break
+			}
+		}
+		return s
+	}
+}
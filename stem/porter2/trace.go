@@ -0,0 +1,108 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package porter2
+
+// Trace describes a step that changed a word. A step that removes a
+// suffix and then, in a nested step, another one is described by a Trace
+// for each of them.
+type Trace struct {
+	// Step is the name of the step, like "step1a".
+	Step string
+	// Suffix is the suffix matched by the step, if known.
+	Suffix string
+	// Region is the name of the region the suffix had to be in, if
+	// any.
+	Region string
+	// Word is the word after the step, or after the removal of Suffix.
+	Word string
+}
+
+// Tracer records the steps that change a word while it's stemmed. All the
+// methods of a nil Tracer do nothing, so a stemmer can call them
+// unconditionally.
+type Tracer struct {
+	Traces []Trace
+	word   string
+	// named is the number of Traces whose Step is known. The actions
+	// recorded by hooked Steps are named by the next Record.
+	named int
+}
+
+// NewTracer returns a Tracer for word.
+func NewTracer(word []byte) *Tracer {
+	return &Tracer{word: string(word)}
+}
+
+// Hook returns a copy of s that records in t every action it performs,
+// nested ones included, with the suffix and the word it produced. The
+// copy always interprets the suffixes, see Interpret. If t is nil s is
+// returned, so stemmers can hook their steps unconditionally.
+func (t *Tracer) Hook(s *Step) *Step {
+	if t == nil || s == nil {
+		return s
+	}
+	return &Step{suffixes: s.suffixes, tracer: t}
+}
+
+// Record records that step turned the word into word. The actions of the
+// Steps hooked since the previous Record are recorded as part of step.
+// Nothing more is recorded if the word didn't change since the last
+// action; otherwise, if step only removed letters from the end of the
+// word, they are recorded as the suffix.
+func (t *Tracer) Record(step string, word []byte) {
+	if t == nil {
+		return
+	}
+	t.removed(word)
+	t.name(step)
+}
+
+// RecordSuffix is like Record but for steps that aren't made of Steps and
+// know the suffix they matched and its region.
+func (t *Tracer) RecordSuffix(step string, word []byte, suffix, region string) {
+	if t == nil {
+		return
+	}
+	t.change(word, suffix, region)
+	t.name(step)
+}
+
+// removed records word with the letters removed from the end of the
+// previous form, if that's all that changed, as the suffix.
+func (t *Tracer) removed(word []byte) {
+	suffix := ""
+	if len(word) < len(t.word) && t.word[:len(word)] == string(word) {
+		suffix = t.word[len(word):]
+	}
+	t.change(word, suffix, "")
+}
+
+// change records word, unless the word didn't change.
+func (t *Tracer) change(word []byte, suffix, region string) {
+	if string(word) == t.word {
+		return
+	}
+	t.word = string(word)
+	t.Traces = append(t.Traces, Trace{Suffix: suffix, Region: region, Word: t.word})
+}
+
+// name sets the Step of the Traces recorded since the last call.
+func (t *Tracer) name(step string) {
+	for i := t.named; i < len(t.Traces); i++ {
+		t.Traces[i].Step = step
+	}
+	t.named = len(t.Traces)
+}
@@ -0,0 +1,143 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Interface{}
+)
+
+// iso6393 maps ISO 639-2 and 639-3 codes to ISO 639-1 codes.
+var iso6393 = map[string]string{
+	"dan": "da",
+	"deu": "de",
+	"dut": "nl",
+	"eng": "en",
+	"fin": "fi",
+	"fra": "fr",
+	"fre": "fr",
+	"ger": "de",
+	"hun": "hu",
+	"ita": "it",
+	"nld": "nl",
+	"nob": "no",
+	"nno": "no",
+	"nor": "no",
+	"por": "pt",
+	"rus": "ru",
+	"spa": "es",
+	"swe": "sv",
+}
+
+// canonical returns the ISO 639-1 code of the primary subtag of the
+// BCP 47 language tag code, or the primary subtag itself if there's none.
+// Private use tags, like "x-klingon", have no primary subtag and are
+// kept whole.
+func canonical(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if strings.HasPrefix(code, "x-") || strings.HasPrefix(code, "x_") {
+		return strings.Replace(code, "_", "-", -1)
+	}
+	if i := strings.IndexAny(code, "-_"); i >= 0 {
+		code = code[:i]
+	}
+	if c, ok := iso6393[code]; ok {
+		return c
+	}
+	switch code {
+	case "nb", "nn":
+		return "no"
+	}
+	return code
+}
+
+// Register makes st the stemmer for the language code. code can be a
+// BCP 47 tag or an ISO 639-1 or 639-3 code; only the language is
+// considered, so "pt-BR" registers "pt", but private use tags like
+// "x-klingon" are registered whole.
+// Register panics if st is nil or if a stemmer for the same language is
+// already registered.
+func Register(code string, st Interface) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if st == nil {
+		panic("stem: Register stemmer is nil")
+	}
+	c := canonical(code)
+	if c == "" {
+		panic("stem: Register language code is empty")
+	}
+	if _, dup := registry[c]; dup {
+		panic("stem: Register called twice for language " + c)
+	}
+	registry[c] = st
+}
+
+// unregister removes the stemmer for the language code. It's used by
+// tests to undo Register.
+func unregister(code string) {
+	registryMu.Lock()
+	delete(registry, canonical(code))
+	registryMu.Unlock()
+}
+
+// ForLanguage returns the stemmer registered for the language code.
+// See Register for the accepted codes.
+func ForLanguage(code string) (Interface, error) {
+	registryMu.RLock()
+	st, ok := registry[canonical(code)]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("stem: no stemmer for language %q", code)
+	}
+	return st, nil
+}
+
+// Languages returns the sorted ISO 639-1 codes (or the codes given to
+// Register) of the languages with a stemmer.
+func Languages() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	var ls []string
+	for l := range registry {
+		ls = append(ls, l)
+	}
+	sort.Strings(ls)
+	return ls
+}
+
+func init() {
+	Register("da", Porter2Danish{})
+	Register("de", Porter2German{})
+	Register("en", Porter2English{})
+	Register("es", Porter2Spanish{})
+	Register("fi", Porter2Finnish{})
+	Register("fr", Porter2French{})
+	Register("hu", Porter2Hungarian{})
+	Register("it", Porter2Italian{})
+	Register("nl", Porter2Dutch{})
+	Register("no", Porter2Norwegian{})
+	Register("pt", Porter2Portuguese{})
+	Register("ru", Porter2Russian{})
+	Register("sv", Porter2Swedish{})
+}
@@ -0,0 +1,97 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem_test
+
+import (
+	"sort"
+	"testing"
+
+	"xojoc.pw/nlp/stem"
+)
+
+func TestForLanguage(t *testing.T) {
+	for code, want := range map[string]stem.Interface{
+		"en":    stem.Porter2English{},
+		"EN-us": stem.Porter2English{},
+		"eng":   stem.Porter2English{},
+		"it":    stem.Porter2Italian{},
+		"ita":   stem.Porter2Italian{},
+		"es_MX": stem.Porter2Spanish{},
+		"spa":   stem.Porter2Spanish{},
+		"pt-BR": stem.Porter2Portuguese{},
+		"nb":    stem.Porter2Norwegian{},
+		"ger":   stem.Porter2German{},
+	} {
+		st, err := stem.ForLanguage(code)
+		if err != nil {
+			t.Errorf("ForLanguage(%q): %v", code, err)
+		} else if st != want {
+			t.Errorf("ForLanguage(%q) = %T; want %T", code, st, want)
+		}
+	}
+	for _, code := range []string{"", "xx", "zho"} {
+		if _, err := stem.ForLanguage(code); err == nil {
+			t.Errorf("ForLanguage(%q): expected error", code)
+		}
+	}
+}
+
+type upper struct{ stem.Porter2English }
+
+func TestRegister(t *testing.T) {
+	stem.Register("x-test", upper{})
+	defer stem.Unregister("x-test")
+	st, err := stem.ForLanguage("X_TEST")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := st.(upper); !ok {
+		t.Errorf("ForLanguage(%q) = %T; want upper", "X_TEST", st)
+	}
+	if _, err := stem.ForLanguage("x-other"); err == nil {
+		t.Errorf("ForLanguage(%q): expected error", "x-other")
+	}
+	found := false
+	for _, l := range stem.Languages() {
+		found = found || l == "x-test"
+	}
+	if !found {
+		t.Errorf("Languages() = %v; want x-test in it", stem.Languages())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Register: expected panic on duplicate")
+		}
+	}()
+	stem.Register("X-Test", upper{})
+}
+
+func TestLanguages(t *testing.T) {
+	ls := stem.Languages()
+	for _, l := range []string{"de", "en", "es", "fr", "it"} {
+		i := 0
+		for i < len(ls) && ls[i] != l {
+			i++
+		}
+		if i == len(ls) {
+			t.Errorf("Languages() = %v; %q missing", ls, l)
+		}
+	}
+	if !sort.StringsAreSorted(ls) {
+		t.Errorf("Languages() = %v; not sorted", ls)
+	}
+}
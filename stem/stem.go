@@ -17,9 +17,26 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>. */
 package stem
 
 import (
+	"io"
+
+	"xojoc.pw/nlp/stem/internal/kraaijpohlmann"
+	"xojoc.pw/nlp/stem/internal/lancaster"
+	"xojoc.pw/nlp/stem/internal/lovins"
+	"xojoc.pw/nlp/stem/internal/porter2danish"
+	"xojoc.pw/nlp/stem/internal/porter2dutch"
 	"xojoc.pw/nlp/stem/internal/porter2english"
+	"xojoc.pw/nlp/stem/internal/porter2finnish"
+	"xojoc.pw/nlp/stem/internal/porter2french"
+	"xojoc.pw/nlp/stem/internal/porter2german"
+	"xojoc.pw/nlp/stem/internal/porter2hungarian"
 	"xojoc.pw/nlp/stem/internal/porter2italian"
+	"xojoc.pw/nlp/stem/internal/porter2norwegian"
+	"xojoc.pw/nlp/stem/internal/porter2portuguese"
+	"xojoc.pw/nlp/stem/internal/porter2russian"
 	"xojoc.pw/nlp/stem/internal/porter2spanish"
+	"xojoc.pw/nlp/stem/internal/porter2swedish"
+	"xojoc.pw/nlp/stem/internal/porterenglish"
+	"xojoc.pw/nlp/stem/internal/snowball"
 )
 
 type Interface interface {
@@ -29,12 +46,35 @@ type Interface interface {
 	// StemString returns the stem of s.
 	StemString(s string) string
 	// StemBytes assumes the input is already normalized.
-	// You can use NormalizeBytes to normalize it.
+	// You can use NormalizeBytes to normalize it: it trims spaces,
+	// composes (NFC) and case folds letters, turns ’ ‘ and ‛ into '
+	// and expands ligatures like ﬁ and œ.
 	// NOTE: NormalizeBytes may modify b or return a sublice of it.
 	NormalizeBytes(b []byte) []byte
 	// StemString assumes the input is already normalized.
 	// You can use NormalizeString to normalize it.
 	NormalizeString(s string) string
+	// AppendStem appends the stem of src to dst and returns the
+	// extended buffer. Unlike StemBytes it never modifies src, which
+	// must not overlap with dst. If dst has enough capacity the stem is
+	// computed without allocating, except by Snowball, which allocates
+	// for every word.
+	AppendStem(dst, src []byte) []byte
+}
+
+// appendStem implements AppendStem by stemming a copy of src at the end
+// of dst with stem.
+func appendStem(dst, src []byte, stem func([]byte) []byte) []byte {
+	n := len(dst)
+	dst = append(dst, src...)
+	s := stem(dst[n:])
+	if len(s) == 0 {
+		return dst[:n]
+	}
+	if len(dst) > n && &s[0] == &dst[n] {
+		return dst[:n+len(s)]
+	}
+	return append(dst[:n], s...)
 }
 
 type Porter2English struct{}
@@ -44,6 +84,9 @@ var _ Interface = Porter2English{}
 func (Porter2English) StemBytes(b []byte) []byte {
 	return porter2english.StemBytes(b)
 }
+func (Porter2English) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2english.StemBytes)
+}
 func (Porter2English) StemString(s string) string {
 	return porter2english.StemString(s)
 }
@@ -53,6 +96,9 @@ func (Porter2English) NormalizeBytes(b []byte) []byte {
 func (Porter2English) NormalizeString(s string) string {
 	return porter2english.NormalizeString(s)
 }
+func (Porter2English) Explain(s string) []Trace {
+	return porter2english.Explain([]byte(s))
+}
 
 type Porter2Italian struct{}
 
@@ -61,6 +107,9 @@ var _ Interface = Porter2Italian{}
 func (Porter2Italian) StemBytes(b []byte) []byte {
 	return porter2italian.StemBytes(b)
 }
+func (Porter2Italian) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2italian.StemBytes)
+}
 func (Porter2Italian) StemString(s string) string {
 	return porter2italian.StemString(s)
 }
@@ -70,6 +119,9 @@ func (Porter2Italian) NormalizeBytes(b []byte) []byte {
 func (Porter2Italian) NormalizeString(s string) string {
 	return porter2italian.NormalizeString(s)
 }
+func (Porter2Italian) Explain(s string) []Trace {
+	return porter2italian.Explain([]byte(s))
+}
 
 type Porter2Spanish struct{}
 
@@ -78,6 +130,9 @@ var _ Interface = Porter2Spanish{}
 func (Porter2Spanish) StemBytes(b []byte) []byte {
 	return porter2spanish.StemBytes(b)
 }
+func (Porter2Spanish) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2spanish.StemBytes)
+}
 func (Porter2Spanish) StemString(s string) string {
 	return porter2spanish.StemString(s)
 }
@@ -87,3 +142,391 @@ func (Porter2Spanish) NormalizeBytes(b []byte) []byte {
 func (Porter2Spanish) NormalizeString(s string) string {
 	return porter2spanish.NormalizeString(s)
 }
+func (Porter2Spanish) Explain(s string) []Trace {
+	return porter2spanish.Explain([]byte(s))
+}
+
+type Porter2French struct{}
+
+var _ Interface = Porter2French{}
+
+func (Porter2French) StemBytes(b []byte) []byte {
+	return porter2french.StemBytes(b)
+}
+func (Porter2French) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2french.StemBytes)
+}
+func (Porter2French) StemString(s string) string {
+	return porter2french.StemString(s)
+}
+func (Porter2French) NormalizeBytes(b []byte) []byte {
+	return porter2french.NormalizeBytes(b)
+}
+func (Porter2French) NormalizeString(s string) string {
+	return porter2french.NormalizeString(s)
+}
+func (Porter2French) Explain(s string) []Trace {
+	return porter2french.Explain([]byte(s))
+}
+
+type Porter2German struct{}
+
+var _ Interface = Porter2German{}
+
+func (Porter2German) StemBytes(b []byte) []byte {
+	return porter2german.StemBytes(b)
+}
+func (Porter2German) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2german.StemBytes)
+}
+func (Porter2German) StemString(s string) string {
+	return porter2german.StemString(s)
+}
+func (Porter2German) NormalizeBytes(b []byte) []byte {
+	return porter2german.NormalizeBytes(b)
+}
+func (Porter2German) NormalizeString(s string) string {
+	return porter2german.NormalizeString(s)
+}
+func (Porter2German) Explain(s string) []Trace {
+	return porter2german.Explain([]byte(s))
+}
+
+type Porter2Portuguese struct{}
+
+var _ Interface = Porter2Portuguese{}
+
+func (Porter2Portuguese) StemBytes(b []byte) []byte {
+	return porter2portuguese.StemBytes(b)
+}
+func (Porter2Portuguese) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2portuguese.StemBytes)
+}
+func (Porter2Portuguese) StemString(s string) string {
+	return porter2portuguese.StemString(s)
+}
+func (Porter2Portuguese) NormalizeBytes(b []byte) []byte {
+	return porter2portuguese.NormalizeBytes(b)
+}
+func (Porter2Portuguese) NormalizeString(s string) string {
+	return porter2portuguese.NormalizeString(s)
+}
+func (Porter2Portuguese) Explain(s string) []Trace {
+	return porter2portuguese.Explain([]byte(s))
+}
+
+type Porter2Russian struct{}
+
+var _ Interface = Porter2Russian{}
+
+func (Porter2Russian) StemBytes(b []byte) []byte {
+	return porter2russian.StemBytes(b)
+}
+func (Porter2Russian) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2russian.StemBytes)
+}
+func (Porter2Russian) StemString(s string) string {
+	return porter2russian.StemString(s)
+}
+func (Porter2Russian) NormalizeBytes(b []byte) []byte {
+	return porter2russian.NormalizeBytes(b)
+}
+func (Porter2Russian) NormalizeString(s string) string {
+	return porter2russian.NormalizeString(s)
+}
+func (Porter2Russian) Explain(s string) []Trace {
+	return porter2russian.Explain([]byte(s))
+}
+
+type Porter2Swedish struct{}
+
+var _ Interface = Porter2Swedish{}
+
+func (Porter2Swedish) StemBytes(b []byte) []byte {
+	return porter2swedish.StemBytes(b)
+}
+func (Porter2Swedish) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2swedish.StemBytes)
+}
+func (Porter2Swedish) StemString(s string) string {
+	return porter2swedish.StemString(s)
+}
+func (Porter2Swedish) NormalizeBytes(b []byte) []byte {
+	return porter2swedish.NormalizeBytes(b)
+}
+func (Porter2Swedish) NormalizeString(s string) string {
+	return porter2swedish.NormalizeString(s)
+}
+func (Porter2Swedish) Explain(s string) []Trace {
+	return porter2swedish.Explain([]byte(s))
+}
+
+type Porter2Norwegian struct{}
+
+var _ Interface = Porter2Norwegian{}
+
+func (Porter2Norwegian) StemBytes(b []byte) []byte {
+	return porter2norwegian.StemBytes(b)
+}
+func (Porter2Norwegian) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2norwegian.StemBytes)
+}
+func (Porter2Norwegian) StemString(s string) string {
+	return porter2norwegian.StemString(s)
+}
+func (Porter2Norwegian) NormalizeBytes(b []byte) []byte {
+	return porter2norwegian.NormalizeBytes(b)
+}
+func (Porter2Norwegian) NormalizeString(s string) string {
+	return porter2norwegian.NormalizeString(s)
+}
+func (Porter2Norwegian) Explain(s string) []Trace {
+	return porter2norwegian.Explain([]byte(s))
+}
+
+type Porter2Danish struct{}
+
+var _ Interface = Porter2Danish{}
+
+func (Porter2Danish) StemBytes(b []byte) []byte {
+	return porter2danish.StemBytes(b)
+}
+func (Porter2Danish) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2danish.StemBytes)
+}
+func (Porter2Danish) StemString(s string) string {
+	return porter2danish.StemString(s)
+}
+func (Porter2Danish) NormalizeBytes(b []byte) []byte {
+	return porter2danish.NormalizeBytes(b)
+}
+func (Porter2Danish) NormalizeString(s string) string {
+	return porter2danish.NormalizeString(s)
+}
+func (Porter2Danish) Explain(s string) []Trace {
+	return porter2danish.Explain([]byte(s))
+}
+
+type Porter2Dutch struct{}
+
+var _ Interface = Porter2Dutch{}
+
+func (Porter2Dutch) StemBytes(b []byte) []byte {
+	return porter2dutch.StemBytes(b)
+}
+func (Porter2Dutch) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2dutch.StemBytes)
+}
+func (Porter2Dutch) StemString(s string) string {
+	return porter2dutch.StemString(s)
+}
+func (Porter2Dutch) NormalizeBytes(b []byte) []byte {
+	return porter2dutch.NormalizeBytes(b)
+}
+func (Porter2Dutch) NormalizeString(s string) string {
+	return porter2dutch.NormalizeString(s)
+}
+func (Porter2Dutch) Explain(s string) []Trace {
+	return porter2dutch.Explain([]byte(s))
+}
+
+type KraaijPohlmann struct{}
+
+var _ Interface = KraaijPohlmann{}
+
+func (KraaijPohlmann) StemBytes(b []byte) []byte {
+	return kraaijpohlmann.StemBytes(b)
+}
+func (KraaijPohlmann) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, kraaijpohlmann.StemBytes)
+}
+func (KraaijPohlmann) StemString(s string) string {
+	return kraaijpohlmann.StemString(s)
+}
+func (KraaijPohlmann) NormalizeBytes(b []byte) []byte {
+	return kraaijpohlmann.NormalizeBytes(b)
+}
+func (KraaijPohlmann) NormalizeString(s string) string {
+	return kraaijpohlmann.NormalizeString(s)
+}
+func (KraaijPohlmann) Explain(s string) []Trace {
+	return kraaijpohlmann.Explain([]byte(s))
+}
+
+type Porter2Finnish struct{}
+
+var _ Interface = Porter2Finnish{}
+
+func (Porter2Finnish) StemBytes(b []byte) []byte {
+	return porter2finnish.StemBytes(b)
+}
+func (Porter2Finnish) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2finnish.StemBytes)
+}
+func (Porter2Finnish) StemString(s string) string {
+	return porter2finnish.StemString(s)
+}
+func (Porter2Finnish) NormalizeBytes(b []byte) []byte {
+	return porter2finnish.NormalizeBytes(b)
+}
+func (Porter2Finnish) NormalizeString(s string) string {
+	return porter2finnish.NormalizeString(s)
+}
+func (Porter2Finnish) Explain(s string) []Trace {
+	return porter2finnish.Explain([]byte(s))
+}
+
+type Porter2Hungarian struct{}
+
+var _ Interface = Porter2Hungarian{}
+
+func (Porter2Hungarian) StemBytes(b []byte) []byte {
+	return porter2hungarian.StemBytes(b)
+}
+func (Porter2Hungarian) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porter2hungarian.StemBytes)
+}
+func (Porter2Hungarian) StemString(s string) string {
+	return porter2hungarian.StemString(s)
+}
+func (Porter2Hungarian) NormalizeBytes(b []byte) []byte {
+	return porter2hungarian.NormalizeBytes(b)
+}
+func (Porter2Hungarian) NormalizeString(s string) string {
+	return porter2hungarian.NormalizeString(s)
+}
+func (Porter2Hungarian) Explain(s string) []Trace {
+	return porter2hungarian.Explain([]byte(s))
+}
+
+type PorterEnglish struct{}
+
+var _ Interface = PorterEnglish{}
+
+func (PorterEnglish) StemBytes(b []byte) []byte {
+	return porterenglish.StemBytes(b)
+}
+func (PorterEnglish) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, porterenglish.StemBytes)
+}
+func (PorterEnglish) StemString(s string) string {
+	return porterenglish.StemString(s)
+}
+func (PorterEnglish) NormalizeBytes(b []byte) []byte {
+	return porterenglish.NormalizeBytes(b)
+}
+func (PorterEnglish) NormalizeString(s string) string {
+	return porterenglish.NormalizeString(s)
+}
+func (PorterEnglish) Explain(s string) []Trace {
+	return porterenglish.Explain([]byte(s))
+}
+
+// Lancaster is the Paice/Husk stemmer. The zero value uses the standard
+// rule table, use NewLancaster to use different rules.
+type Lancaster struct {
+	st *lancaster.Stemmer
+}
+
+var _ Interface = Lancaster{}
+
+// NewLancaster returns a Lancaster stemmer using the rules read from r,
+// written in the Paice/Husk rule file format.
+func NewLancaster(r io.Reader) (Lancaster, error) {
+	st, err := lancaster.New(r)
+	if err != nil {
+		return Lancaster{}, err
+	}
+	return Lancaster{st}, nil
+}
+
+func (l Lancaster) StemBytes(b []byte) []byte {
+	if l.st == nil {
+		return lancaster.StemBytes(b)
+	}
+	return l.st.StemBytes(b)
+}
+func (l Lancaster) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, l.StemBytes)
+}
+func (l Lancaster) StemString(s string) string {
+	return string(l.StemBytes([]byte(s)))
+}
+func (Lancaster) NormalizeBytes(b []byte) []byte {
+	return lancaster.NormalizeBytes(b)
+}
+func (Lancaster) NormalizeString(s string) string {
+	return lancaster.NormalizeString(s)
+}
+func (l Lancaster) Explain(s string) []Trace {
+	if l.st == nil {
+		return lancaster.Explain([]byte(s))
+	}
+	return l.st.Explain([]byte(s))
+}
+
+type Lovins struct{}
+
+var _ Interface = Lovins{}
+
+func (Lovins) StemBytes(b []byte) []byte {
+	return lovins.StemBytes(b)
+}
+func (Lovins) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, lovins.StemBytes)
+}
+func (Lovins) StemString(s string) string {
+	return lovins.StemString(s)
+}
+func (Lovins) NormalizeBytes(b []byte) []byte {
+	return lovins.NormalizeBytes(b)
+}
+func (Lovins) NormalizeString(s string) string {
+	return lovins.NormalizeString(s)
+}
+func (Lovins) Explain(s string) []Trace {
+	return lovins.Explain([]byte(s))
+}
+
+// Snowball runs a stemmer written in the Snowball language. The zero
+// value returns words unchanged, use NewSnowball to load a program.
+// Programs run on a copy of the word decoded to runes, so all methods,
+// AppendStem included, allocate for every word.
+type Snowball struct {
+	p *snowball.Program
+}
+
+var _ Interface = Snowball{}
+
+// NewSnowball compiles the Snowball program read from r. The program
+// must define the external routine stem. Files read with get are opened
+// relative to the working directory.
+func NewSnowball(r io.Reader) (Snowball, error) {
+	p, err := snowball.Parse(r)
+	if err != nil {
+		return Snowball{}, err
+	}
+	return Snowball{p}, nil
+}
+
+func (s Snowball) StemBytes(b []byte) []byte {
+	if s.p == nil {
+		return b
+	}
+	return s.p.StemBytes(b)
+}
+func (s Snowball) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, s.StemBytes)
+}
+func (s Snowball) StemString(str string) string {
+	if s.p == nil {
+		return str
+	}
+	return s.p.StemString(str)
+}
+func (Snowball) NormalizeBytes(b []byte) []byte {
+	return snowball.NormalizeBytes(b)
+}
+func (Snowball) NormalizeString(s string) string {
+	return snowball.NormalizeString(s)
+}
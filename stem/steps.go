@@ -0,0 +1,62 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem
+
+import (
+	"strconv"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+	"xojoc.pw/nlp/stem/porter2"
+)
+
+// Steps is a stemmer made of porter2 steps, applied in order. Words are
+// normalized like for the other stemmers: spaces are trimmed, letters
+// composed and case folded, apostrophes unified and ligatures expanded.
+// AppendStem only allocates if the callbacks of the steps do, for example
+// by growing the word past the capacity of dst.
+type Steps []*porter2.Step
+
+var _ Interface = Steps{}
+
+func (s Steps) StemBytes(b []byte) []byte {
+	for _, st := range s {
+		b = st.Apply(b)
+	}
+	return b
+}
+func (s Steps) AppendStem(dst, src []byte) []byte {
+	return appendStem(dst, src, s.StemBytes)
+}
+func (s Steps) StemString(str string) string {
+	return string(s.StemBytes([]byte(str)))
+}
+func (Steps) NormalizeBytes(b []byte) []byte {
+	return textnorm.Bytes(b)
+}
+func (s Steps) NormalizeString(str string) string {
+	return string(s.NormalizeBytes([]byte(str)))
+}
+
+// Explain names the steps step1, step2 and so on.
+func (s Steps) Explain(str string) []Trace {
+	b := []byte(str)
+	t := porter2.NewTracer(b)
+	for i, st := range s {
+		b = t.Hook(st).Apply(b)
+		t.Record("step"+strconv.Itoa(i+1), b)
+	}
+	return t.Traces
+}
@@ -0,0 +1,193 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"xojoc.pw/nlp/stem/internal/textnorm"
+)
+
+// WordSet is a set of normalized words. Its methods don't allocate.
+type WordSet struct {
+	words map[string]struct{}
+}
+
+// NewWordSet returns a set of words, which are normalized like
+// NormalizeString does.
+func NewWordSet(words ...string) *WordSet {
+	s := &WordSet{words: make(map[string]struct{}, len(words))}
+	s.Add(words...)
+	return s
+}
+
+// Contains reports whether word is in s. word must be normalized.
+func (s *WordSet) Contains(word []byte) bool {
+	_, ok := s.words[string(word)]
+	return ok
+}
+
+// ContainsString reports whether word is in s. word must be normalized.
+func (s *WordSet) ContainsString(word string) bool {
+	_, ok := s.words[word]
+	return ok
+}
+
+// Add adds words to s.
+func (s *WordSet) Add(words ...string) {
+	for _, w := range words {
+		if w = textnorm.String(w); w != "" {
+			s.words[w] = struct{}{}
+		}
+	}
+}
+
+// Remove removes words from s.
+func (s *WordSet) Remove(words ...string) {
+	for _, w := range words {
+		delete(s.words, textnorm.String(w))
+	}
+}
+
+// Len returns the number of words in s.
+func (s *WordSet) Len() int {
+	return len(s.words)
+}
+
+// Words returns the sorted words of s.
+func (s *WordSet) Words() []string {
+	ws := make([]string, 0, len(s.words))
+	for w := range s.words {
+		ws = append(ws, w)
+	}
+	sort.Strings(ws)
+	return ws
+}
+
+// Load reads white space separated words from r and adds them to s.
+// Words starting with - are removed instead and lines starting with #
+// are comments:
+//
+//	# not a stopword for us
+//	-us
+//	foo bar
+func (s *WordSet) Load(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, w := range strings.Fields(line) {
+			if strings.HasPrefix(w, "-") {
+				s.Remove(w[1:])
+			} else {
+				s.Add(w)
+			}
+		}
+	}
+	return sc.Err()
+}
+
+// Stopwords returns a new set with the stopwords of the language code,
+// which is accepted in the forms described by Register. English,
+// Italian and Spanish are supported.
+func Stopwords(code string) (*WordSet, error) {
+	list, ok := stopwords[canonical(code)]
+	if !ok {
+		return nil, fmt.Errorf("stem: no stopwords for language %q", code)
+	}
+	return NewWordSet(strings.Fields(list)...), nil
+}
+
+// stopwords are the stopword lists of snowballstem.org.
+var stopwords = map[string]string{
+	"en": `
+i me my myself we our ours ourselves you your yours yourself yourselves
+he him his himself she her hers herself it its itself they them their
+theirs themselves what which who whom this that these those am is are
+was were be been being have has had having do does did doing would
+should could ought i'm you're he's she's it's we're they're i've you've
+we've they've i'd you'd he'd she'd we'd they'd i'll you'll he'll she'll
+we'll they'll isn't aren't wasn't weren't hasn't haven't hadn't doesn't
+don't didn't won't wouldn't shan't shouldn't can't cannot couldn't
+mustn't let's that's who's what's here's there's when's where's why's
+how's a an the and but if or because as until while of at by for with
+about against between into through during before after above below to
+from up down in out on off over under again further then once here
+there when where why how all any both each few more most other some
+such no nor not only own same so than too very`,
+	"it": `
+ad al allo ai agli all agl alla alle con col coi da dal dallo dai dagli
+dall dagl dalla dalle di del dello dei degli dell degl della delle in
+nel nello nei negli nell negl nella nelle su sul sullo sui sugli sull
+sugl sulla sulle per tra contro io tu lui lei noi voi loro mio mia miei
+mie tuo tua tuoi tue suo sua suoi sue nostro nostra nostri nostre
+vostro vostra vostri vostre mi ti ci vi lo la li le gli ne il un uno
+una ma ed se perché anche come dov dove che chi cui non più quale
+quanto quanti quanta quante quello quelli quella quelle questo questi
+questa queste si tutto tutti a c e i l o ho hai ha abbiamo avete hanno
+abbia abbiate abbiano avrò avrai avrà avremo avrete avranno avrei
+avresti avrebbe avremmo avreste avrebbero avevo avevi aveva avevamo
+avevate avevano ebbi avesti ebbe avemmo aveste ebbero avessi avesse
+avessimo avessero avendo avuto avuta avuti avute sono sei è siamo siete
+sia siate siano sarò sarai sarà saremo sarete saranno sarei saresti
+sarebbe saremmo sareste sarebbero ero eri era eravamo eravate erano fui
+fosti fu fummo foste furono fossi fosse fossimo fossero essendo faccio
+fai facciamo fanno faccia facciate facciano farò farai farà faremo
+farete faranno farei faresti farebbe faremmo fareste farebbero facevo
+facevi faceva facevamo facevate facevano feci facesti fece facemmo
+faceste fecero facessi facesse facessimo facessero facendo sto stai sta
+stiamo stanno stia stiate stiano starò starai starà staremo starete
+staranno starei staresti starebbe staremmo stareste starebbero stavo
+stavi stava stavamo stavate stavano stetti stesti stette stemmo steste
+stettero stessi stesse stessimo stessero stando`,
+	"es": `
+de la que el en y a los del se las por un para con no una su al lo como
+más pero sus le ya o este sí porque esta entre cuando muy sin sobre
+también me hasta hay donde quien desde todo nos durante todos uno les
+ni contra otros ese eso ante ellos e esto mí antes algunos qué unos yo
+otro otras otra él tanto esa estos mucho quienes nada muchos cual poco
+ella estar estas algunas algo nosotros mi mis tú te ti tu tus ellas
+nosotras vosotros vosotras os mío mía míos mías tuyo tuya tuyos tuyas
+suyo suya suyos suyas nuestro nuestra nuestros nuestras vuestro vuestra
+vuestros vuestras esos esas estoy estás está estamos estáis están esté
+estés estemos estéis estén estaré estarás estará estaremos estaréis
+estarán estaría estarías estaríamos estaríais estarían estaba estabas
+estábamos estabais estaban estuve estuviste estuvo estuvimos estuvisteis
+estuvieron estuviera estuvieras estuviéramos estuvierais estuvieran
+estuviese estuvieses estuviésemos estuvieseis estuviesen estando estado
+estada estados estadas estad he has ha hemos habéis han haya hayas
+hayamos hayáis hayan habré habrás habrá habremos habréis habrán habría
+habrías habríamos habríais habrían había habías habíamos habíais habían
+hube hubiste hubo hubimos hubisteis hubieron hubiera hubieras
+hubiéramos hubierais hubieran hubiese hubieses hubiésemos hubieseis
+hubiesen habiendo habido habida habidos habidas soy eres es somos sois
+son sea seas seamos seáis sean seré serás será seremos seréis serán
+sería serías seríamos seríais serían era eras éramos erais eran fui
+fuiste fue fuimos fuisteis fueron fuera fueras fuéramos fuerais fueran
+fuese fueses fuésemos fueseis fuesen siendo sido tengo tienes tiene
+tenemos tenéis tienen tenga tengas tengamos tengáis tengan tendré
+tendrás tendrá tendremos tendréis tendrán tendría tendrías tendríamos
+tendríais tendrían tenía tenías teníamos teníais tenían tuve tuviste
+tuvo tuvimos tuvisteis tuvieron tuviera tuvieras tuviéramos tuvierais
+tuvieran tuviese tuvieses tuviésemos tuvieseis tuviesen teniendo tenido
+tenida tenidos tenidas tened`,
+}
@@ -0,0 +1,86 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem_test
+
+import (
+	"strings"
+	"testing"
+
+	"xojoc.pw/nlp/stem"
+)
+
+func TestStopwords(t *testing.T) {
+	for code, words := range map[string]string{
+		"en":    "the of don't very",
+		"it-IT": "di perché è stessimo",
+		"spa":   "de más él tened",
+	} {
+		s, err := stem.Stopwords(code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, w := range strings.Fields(words) {
+			if !s.Contains([]byte(w)) {
+				t.Errorf("Stopwords(%q) doesn't contain %q", code, w)
+			}
+		}
+		if s.ContainsString("stem") {
+			t.Errorf("Stopwords(%q) contains %q", code, "stem")
+		}
+	}
+	if _, err := stem.Stopwords("xx"); err == nil {
+		t.Error("Stopwords(\"xx\") didn't fail")
+	}
+}
+
+func TestWordSetLoad(t *testing.T) {
+	s, err := stem.Stopwords("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := s.Len()
+	err = s.Load(strings.NewReader(`
+# domain words
+Foo  bar
+-the -US -notthere
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.ContainsString("foo") || !s.ContainsString("bar") || s.ContainsString("the") || s.ContainsString("us") {
+		t.Errorf("Load didn't add or remove words: %v", s.Words())
+	}
+	// foo and bar added, the removed
+	if s.Len() != n+1 {
+		t.Errorf("Len() = %d; want %d", s.Len(), n+1)
+	}
+	if o, _ := stem.Stopwords("en"); !o.ContainsString("the") {
+		t.Error("Load modified the bundled list")
+	}
+}
+
+func TestWordSetAllocs(t *testing.T) {
+	s, _ := stem.Stopwords("it")
+	in, out := []byte("perché"), []byte("parola")
+	allocs := testing.AllocsPerRun(100, func() {
+		if !s.Contains(in) || s.Contains(out) {
+			t.Fatal("wrong Contains")
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Contains allocates %v times", allocs)
+	}
+}
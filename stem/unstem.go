@@ -0,0 +1,176 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Unstemmer learns which words a stemmer conflates to each stem, so that
+// stems like "commun" can be shown to users as the word they come from
+// most often, like "communication".
+//
+// Adding forms isn't safe for concurrent use, the other methods are.
+type Unstemmer struct {
+	st Interface
+	// forms maps stems to the count of each of their forms.
+	forms map[string]map[string]int
+}
+
+// Form is a word and the number of times it was seen.
+type Form struct {
+	Word  string
+	Count int
+}
+
+// NewUnstemmer returns an empty Unstemmer using st.
+func NewUnstemmer(st Interface) *Unstemmer {
+	return &Unstemmer{st: st, forms: map[string]map[string]int{}}
+}
+
+// Add normalizes and stems word and counts word, as it is, as a form of
+// the stem, which is returned. Pass words as they should be displayed:
+// "Paris" keeps its capital letter.
+func (u *Unstemmer) Add(word string) string {
+	word = strings.TrimSpace(word)
+	stem := u.st.StemString(u.st.NormalizeString(word))
+	u.AddForm(word, stem, 1)
+	return stem
+}
+
+// AddForm counts n times form as a form of stem, for pairs computed
+// elsewhere.
+func (u *Unstemmer) AddForm(form, stem string, n int) {
+	if form == "" || stem == "" || n <= 0 {
+		return
+	}
+	m := u.forms[stem]
+	if m == nil {
+		m = map[string]int{}
+		u.forms[stem] = m
+	}
+	m[form] += n
+}
+
+// Forms returns the forms of stem, the most frequent first. Forms with
+// the same count are sorted by length and then alphabetically.
+func (u *Unstemmer) Forms(stem string) []Form {
+	m := u.forms[stem]
+	forms := make([]Form, 0, len(m))
+	for w, n := range m {
+		forms = append(forms, Form{Word: w, Count: n})
+	}
+	sort.Slice(forms, func(i, j int) bool {
+		return forms[i].before(forms[j])
+	})
+	return forms
+}
+
+// before reports whether f comes before g in Forms.
+func (f Form) before(g Form) bool {
+	if f.Count != g.Count {
+		return f.Count > g.Count
+	}
+	if len(f.Word) != len(g.Word) {
+		return len(f.Word) < len(g.Word)
+	}
+	return f.Word < g.Word
+}
+
+// Best returns the form to display for stem, which is the first of
+// Forms. ok is false if no form of stem was added.
+func (u *Unstemmer) Best(stem string) (form string, ok bool) {
+	best := Form{}
+	for w, n := range u.forms[stem] {
+		if f := (Form{Word: w, Count: n}); best.Count == 0 || f.before(best) {
+			best = f
+		}
+	}
+	return best.Word, best.Count > 0
+}
+
+// Stems returns the sorted stems seen so far.
+func (u *Unstemmer) Stems() []string {
+	stems := make([]string, 0, len(u.forms))
+	for s := range u.forms {
+		stems = append(stems, s)
+	}
+	sort.Strings(stems)
+	return stems
+}
+
+// Save writes the model to w as tab separated lines of stem, form and
+// count, sorted by stem and then like Forms. Stems and forms containing
+// tabs or line breaks, or starting with a double quote, are quoted like
+// Go strings.
+func (u *Unstemmer) Save(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, s := range u.Stems() {
+		for _, f := range u.Forms(s) {
+			fmt.Fprintf(bw, "%s\t%s\t%d\n", quote(s), quote(f.Word), f.Count)
+		}
+	}
+	return bw.Flush()
+}
+
+// quote quotes s for Save if needed.
+func quote(s string) string {
+	if strings.ContainsAny(s, "\t\n\r") || strings.HasPrefix(s, `"`) {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// unquote undoes quote.
+func unquote(s string) (string, error) {
+	if strings.HasPrefix(s, `"`) {
+		return strconv.Unquote(s)
+	}
+	return s, nil
+}
+
+// Load reads a model written by Save and adds its counts to u.
+func (u *Unstemmer) Load(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		if sc.Text() == "" {
+			continue
+		}
+		fields := strings.Split(sc.Text(), "\t")
+		if len(fields) != 3 {
+			return fmt.Errorf("stem: unstemmer line %d: want stem, form and count separated by tabs", n)
+		}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil || count <= 0 {
+			return fmt.Errorf("stem: unstemmer line %d: bad count %q", n, fields[2])
+		}
+		stem, err := unquote(fields[0])
+		if err != nil {
+			return fmt.Errorf("stem: unstemmer line %d: bad stem %q", n, fields[0])
+		}
+		form, err := unquote(fields[1])
+		if err != nil {
+			return fmt.Errorf("stem: unstemmer line %d: bad form %q", n, fields[1])
+		}
+		u.AddForm(form, stem, count)
+	}
+	return sc.Err()
+}
@@ -0,0 +1,93 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package stem_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"xojoc.pw/nlp/stem"
+)
+
+const unstemCorpus = `Communication is key. The communications team communicates
+with communities; a community is a communicative group. Generous
+generals generally generate general generalizations, generally.`
+
+func newUnstemmer() *stem.Unstemmer {
+	u := stem.NewUnstemmer(stem.Porter2English{})
+	for _, w := range strings.Fields(unstemCorpus) {
+		u.Add(strings.Trim(w, ".;,"))
+	}
+	return u
+}
+
+func TestUnstemmer(t *testing.T) {
+	u := newUnstemmer()
+	for stem, want := range map[string]string{
+		"communic": "communicates",
+		"general":  "generally",
+		"the":      "The",
+	} {
+		if got, ok := u.Best(stem); !ok || got != want {
+			t.Errorf("Best(%q) = %q, %v; want %q", stem, got, ok, want)
+		}
+	}
+	if _, ok := u.Best("xyz"); ok {
+		t.Error("Best of an unknown stem is ok")
+	}
+	want := []stem.Form{{Word: "generally", Count: 2}, {Word: "general", Count: 1}, {Word: "generals", Count: 1}, {Word: "generalizations", Count: 1}}
+	if got := u.Forms("general"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Forms(general) = %v; want %v", got, want)
+	}
+	if got := u.Forms("xyz"); len(got) != 0 {
+		t.Errorf("Forms(xyz) = %v", got)
+	}
+}
+
+func TestUnstemmerSave(t *testing.T) {
+	u := newUnstemmer()
+	u.AddForm("new\tyork", "new york", 2)
+	u.AddForm("two\nlines\r", "line", 1)
+	u.AddForm(`"quoted"`, `"quot`, 1)
+	var buf bytes.Buffer
+	if err := u.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	v := stem.NewUnstemmer(stem.Porter2English{})
+	if err := v.Load(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(u.Stems(), v.Stems()) {
+		t.Fatalf("Stems() = %v; want %v", v.Stems(), u.Stems())
+	}
+	for _, s := range u.Stems() {
+		if !reflect.DeepEqual(u.Forms(s), v.Forms(s)) {
+			t.Errorf("Forms(%q) = %v; want %v", s, v.Forms(s), u.Forms(s))
+		}
+	}
+	var again bytes.Buffer
+	v.Save(&again)
+	if again.String() != buf.String() {
+		t.Errorf("Save isn't stable:\n%s\n%s", buf.String(), again.String())
+	}
+	for _, bad := range []string{"a\tb\n", "a\tb\tc\n", "a\tb\t-1\n", "\"a\tb\t1\n", "a\t\"b\\q\"\t1\n"} {
+		if err := v.Load(strings.NewReader(bad)); err == nil {
+			t.Errorf("Load(%q) didn't fail", bad)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package tokenize
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// prop is the Word_Break property of a character. It's derived from the
+// general category and the script of the character, which is a good
+// enough approximation of the tables of UAX #29 for words. ideographic
+// characters (Han, Hiragana) are words by themselves, none marks the
+// end of the input.
+type prop uint8
+
+const (
+	other prop = iota
+	cr
+	lf
+	newline
+	extend
+	zwj
+	regionalIndicator
+	format
+	katakana
+	hebrewLetter
+	aLetter
+	singleQuote
+	doubleQuote
+	midNumLet
+	midLetter
+	midNum
+	numeric
+	extendNumLet
+	wSegSpace
+	ideographic
+	none
+)
+
+// asciiProps caches the properties of ASCII characters.
+var asciiProps [utf8.RuneSelf]prop
+
+func init() {
+	for r := range asciiProps {
+		asciiProps[r] = unicodeProperty(rune(r))
+	}
+}
+
+func property(r rune) prop {
+	if 0 <= r && r < utf8.RuneSelf {
+		return asciiProps[r]
+	}
+	return unicodeProperty(r)
+}
+
+func unicodeProperty(r rune) prop {
+	switch r {
+	case '\r':
+		return cr
+	case '\n':
+		return lf
+	case '\v', '\f', 0x85, 0x2028, 0x2029:
+		return newline
+	case 0x200D:
+		return zwj
+	case '\'':
+		return singleQuote
+	case '"':
+		return doubleQuote
+	case '.', 0x2018, 0x2019, 0x2024, 0xFE52, 0xFF07, 0xFF0E:
+		return midNumLet
+	case ':', 0xB7, 0x387, 0x55F, 0x5F4, 0x2027, 0xFE13, 0xFE55, 0xFF1A:
+		return midLetter
+	case ',', ';', 0x37E, 0x589, 0x60C, 0x60D, 0x66C, 0x7F8, 0x2044, 0xFE10, 0xFE14, 0xFE50, 0xFE54, 0xFF0C, 0xFF1B:
+		return midNum
+	case 0x202F:
+		return extendNumLet
+	case 0x3031, 0x3032, 0x3033, 0x3034, 0x3035, 0x309B, 0x309C, 0x30A0, 0x30FC, 0xFF70:
+		return katakana
+	}
+	switch {
+	case 0x1F1E6 <= r && r <= 0x1F1FF:
+		return regionalIndicator
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+		return extend
+	case unicode.Is(unicode.Cf, r) && r != 0x200B:
+		return format
+	case unicode.Is(unicode.Nd, r):
+		return numeric
+	case unicode.Is(unicode.Pc, r):
+		return extendNumLet
+	case unicode.Is(unicode.Zs, r):
+		return wSegSpace
+	case !unicode.IsLetter(r):
+		return other
+	case unicode.Is(unicode.Katakana, r):
+		return katakana
+	case unicode.In(r, unicode.Han, unicode.Hiragana):
+		return ideographic
+	case unicode.Is(unicode.Hebrew, r):
+		return hebrewLetter
+	}
+	return aLetter
+}
+
+// isAHLetter is AHLetter of UAX #29.
+func isAHLetter(p prop) bool {
+	return p == aLetter || p == hebrewLetter
+}
+
+// isMidNumLetQ is MidNumLetQ of UAX #29.
+func isMidNumLetQ(p prop) bool {
+	return p == midNumLet || p == singleQuote
+}
+
+func isLetter(p prop) bool {
+	return p == aLetter || p == hebrewLetter || p == katakana || p == ideographic
+}
+
+func isWordStart(p prop) bool {
+	return isLetter(p) || p == numeric || p == extendNumLet
+}
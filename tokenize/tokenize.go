@@ -0,0 +1,467 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+// Package tokenize splits text into words for the stemmers of package
+// stem.
+//
+// Words are found with the word boundaries of Unicode UAX #29
+// (https://unicode.org/reports/tr29/) with a few extensions: hyphenated
+// words, URLs and email addresses are single tokens, the apostrophe of
+// plural possessives (dogs') is kept for the step0 of Porter2 and elided
+// articles (l'amico) can be split from the following word. Spaces,
+// punctuation and symbols aren't returned.
+package tokenize // import "xojoc.pw/nlp/tokenize"
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind is the kind of a Token.
+type Kind uint8
+
+const (
+	// Word contains at least a letter, like "dog's", "l'amico",
+	// "state-of-the-art" and "3rd".
+	Word Kind = iota
+	// Number contains only digits and separators, like "3.14" and
+	// "1,000".
+	Number
+	URL
+	Email
+	// Elision is an elided word split from the word that follows it,
+	// like "l'" in "l'amico". See Scanner.Elisions.
+	Elision
+)
+
+var kindNames = [...]string{"Word", "Number", "URL", "Email", "Elision"}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Token is a token of the input.
+type Token struct {
+	Kind Kind
+	// Bytes is the text of the token, as it appears in the input.
+	Bytes []byte
+	// Start and End are the byte offsets of the token in the input.
+	Start, End int
+}
+
+// Scanner reads the tokens of an io.Reader. Like bufio.Scanner, it's
+// used by calling Scan until it returns false and then checking Err.
+// Tokens longer than 64 KB, which are hardly words, are cut at a
+// character boundary into tokens of at most 64 KB.
+type Scanner struct {
+	// Elisions are the elided words split from the word that follows
+	// them, like ItalianElisions. Keys are lower case and end with an
+	// apostrophe, ’ in the input matches too.
+	Elisions map[string]bool
+
+	sc     *bufio.Scanner
+	offset int
+	tok    Token
+}
+
+// maxTokenSize is the size of the buffer of a Scanner and so the
+// maximum length of a token.
+const maxTokenSize = bufio.MaxScanTokenSize
+
+// NewScanner returns a Scanner reading from r.
+func NewScanner(r io.Reader) *Scanner {
+	s := &Scanner{sc: bufio.NewScanner(r)}
+	s.sc.Buffer(nil, maxTokenSize)
+	s.sc.Split(s.split)
+	return s
+}
+
+// Scan advances to the next token, which is then available with
+// Token. It returns false at the end of the input or on error.
+func (s *Scanner) Scan() bool {
+	if !s.sc.Scan() {
+		return false
+	}
+	s.tok.Bytes = s.sc.Bytes()
+	return true
+}
+
+// Token returns the last token read by Scan. Its Bytes are only valid
+// until the next call to Scan.
+func (s *Scanner) Token() Token {
+	return s.tok
+}
+
+// Err returns the first error, other than io.EOF, met by Scan.
+func (s *Scanner) Err() error {
+	return s.sc.Err()
+}
+
+// Split returns all the tokens of text.
+func Split(text string) []Token {
+	var toks []Token
+	s := NewScanner(strings.NewReader(text))
+	for s.Scan() {
+		t := s.Token()
+		t.Bytes = []byte(text[t.Start:t.End])
+		toks = append(toks, t)
+	}
+	return toks
+}
+
+// ItalianElisions are the most common elided words of Italian.
+var ItalianElisions = wordSet(`l' dell' dall' nell' sull' all' coll' pell'
+	un' quest' quell' bell' sant' buon' nessun' ciascun' alcun' tutt'
+	mezz' gl' d' c' m' t' s' v' n' ch' anch' com' dov' cos'`)
+
+func wordSet(words string) map[string]bool {
+	m := make(map[string]bool)
+	for _, w := range strings.Fields(words) {
+		m[w] = true
+	}
+	return m
+}
+
+// split is the bufio.SplitFunc of s. It skips everything that isn't a
+// token and keeps track of the offset of data in the input.
+func (s *Scanner) split(data []byte, atEOF bool) (int, []byte, error) {
+	i := 0
+	for i < len(data) {
+		if !atEOF && !utf8.FullRune(data[i:]) {
+			break
+		}
+		r, n := utf8.DecodeRune(data[i:])
+		if !isWordStart(property(r)) {
+			i += n
+			continue
+		}
+		kind, end, ok := s.token(data, i, atEOF)
+		if !ok && i == 0 && len(data) >= maxTokenSize {
+			// the buffer is full: end the token where the data ends
+			kind, end, ok = s.token(data, i, true)
+		}
+		if !ok {
+			break
+		}
+		if end < 0 {
+			// only connectors, like ___
+			i = -end
+			continue
+		}
+		s.tok.Kind = kind
+		s.tok.Start = s.offset + i
+		s.tok.End = s.offset + end
+		s.offset += end
+		return end, data[i:end], nil
+	}
+	s.offset += i
+	return i, nil, nil
+}
+
+// token finds the end of the token starting at i. ok is false if more
+// data is needed. A negative end means no token and skip to -end.
+func (s *Scanner) token(data []byte, i int, atEOF bool) (kind Kind, end int, ok bool) {
+	if end, ok = url(data, i, atEOF); !ok || end > 0 {
+		return URL, end, ok
+	}
+	if end, ok = email(data, i, atEOF); !ok || end > 0 {
+		return Email, end, ok
+	}
+	end, letters, digits, ok := word(data, i, atEOF)
+	switch {
+	case !ok:
+		return 0, 0, false
+	case letters:
+		if s.Elisions != nil {
+			if e := s.elision(data[i:end]); e > 0 {
+				return Elision, i + e, true
+			}
+		}
+		return Word, end, true
+	case digits:
+		return Number, end, true
+	}
+	return 0, -end, true
+}
+
+// elision returns the length of the elided word at the start of w or 0.
+func (s *Scanner) elision(w []byte) int {
+	var buf [16]byte
+	n := 0
+	for i := 0; i < len(w) && n < len(buf); {
+		r, l := utf8.DecodeRune(w[i:])
+		i += l
+		switch {
+		case r == '\'' || r == '’':
+			buf[n] = '\''
+			if i < len(w) && s.Elisions[string(buf[:n+1])] {
+				return i
+			}
+			return 0
+		case r < utf8.RuneSelf:
+			buf[n] = byte(unicode.ToLower(r))
+			n++
+		default:
+			return 0
+		}
+	}
+	return 0
+}
+
+// url returns the end of the URL starting at i or 0.
+func url(data []byte, i int, atEOF bool) (end int, ok bool) {
+	d := data[i:]
+	if d[0]|0x20 != 'h' && d[0]|0x20 != 'f' && d[0]|0x20 != 'w' {
+		return 0, true
+	}
+	match := false
+	for _, p := range [...]string{"http://", "https://", "ftp://", "www."} {
+		if len(d) < len(p) {
+			if !atEOF && hasPrefixFold(p, d) {
+				return 0, false
+			}
+			continue
+		}
+		if hasPrefixFold(p, d[:len(p)]) {
+			match = true
+			break
+		}
+	}
+	if !match {
+		return 0, true
+	}
+	j := i
+	for j < len(data) {
+		r, n := utf8.DecodeRune(data[j:])
+		if unicode.IsSpace(r) || strings.ContainsRune(`<>"`, r) || r == utf8.RuneError && n == 1 {
+			break
+		}
+		j += n
+	}
+	if j == len(data) && !atEOF {
+		return 0, false
+	}
+	// trailing punctuation and unbalanced brackets belong to the text
+	for j > i {
+		r, n := utf8.DecodeLastRune(data[i:j])
+		if strings.ContainsRune(".,;:!?'’", r) ||
+			r == ')' && !hasOpening(data[i:j], '(', ')') ||
+			r == ']' && !hasOpening(data[i:j], '[', ']') {
+			j -= n
+			continue
+		}
+		break
+	}
+	return j, true
+}
+
+func hasOpening(b []byte, open, close byte) bool {
+	n := 0
+	for _, c := range b {
+		switch c {
+		case open:
+			n++
+		case close:
+			n--
+		}
+	}
+	return n >= 0
+}
+
+// hasPrefixFold reports whether b is a prefix of s ignoring the case of
+// ASCII letters.
+func hasPrefixFold(s string, b []byte) bool {
+	for k, c := range b {
+		if k >= len(s) || c|0x20 != s[k]|0x20 {
+			return false
+		}
+	}
+	return true
+}
+
+// email returns the end of the email address starting at i or 0.
+func email(data []byte, i int, atEOF bool) (end int, ok bool) {
+	j := i
+	for j < len(data) && isLocal(data[j]) {
+		j++
+	}
+	if j == len(data) {
+		return 0, atEOF
+	}
+	if data[j] != '@' || j == i {
+		return 0, true
+	}
+	j++
+	start := j
+	for j < len(data) && (isAlnum(data[j]) || data[j] == '-' || data[j] == '.' && data[j-1] != '.') {
+		j++
+	}
+	if j == len(data) && !atEOF {
+		return 0, false
+	}
+	for j > start && (data[j-1] == '.' || data[j-1] == '-') {
+		j--
+	}
+	// the domain needs at least two labels
+	if dot := bytes.LastIndexByte(data[start:j], '.'); dot <= 0 {
+		return 0, true
+	}
+	return j, true
+}
+
+func isAlnum(c byte) bool {
+	return 'a' <= c|0x20 && c|0x20 <= 'z' || '0' <= c && c <= '9'
+}
+
+func isLocal(c byte) bool {
+	return isAlnum(c) || strings.IndexByte("._%+-", c) >= 0
+}
+
+// word returns the end of the word starting at i and whether it
+// contains letters and digits.
+func word(data []byte, i int, atEOF bool) (end int, letters, digits bool, ok bool) {
+	r, n := utf8.DecodeRune(data[i:])
+	prev := property(r)
+	if prev == ideographic {
+		return i + n, true, false, true
+	}
+	letters = isLetter(prev)
+	digits = prev == numeric
+	last := r
+	j := i + n
+	for {
+		p, r, n, ok := peek(data, j, atEOF)
+		if !ok {
+			return 0, false, false, false
+		}
+		if p == extend || p == format || p == zwj {
+			// WB4
+			j += n
+			continue
+		}
+		switch {
+		case joins(prev, p):
+			j += n
+			prev = p
+			last = r
+			letters = letters || isLetter(p)
+			digits = digits || p == numeric
+			continue
+		case prev == hebrewLetter && p == singleQuote:
+			// WB7a
+			j += n
+			prev = p
+			continue
+		case isAHLetter(prev) && (p == midLetter || isMidNumLetQ(p)),
+			prev == numeric && (p == midNum || isMidNumLetQ(p)),
+			prev == hebrewLetter && p == doubleQuote,
+			(isAHLetter(prev) || prev == numeric) && (r == '-' || r == '‐'):
+			q, k, ok := peekBase(data, j+n, atEOF)
+			if !ok {
+				return 0, false, false, false
+			}
+			if midJoins(prev, p, r, q) {
+				// WB6, WB7, WB7b, WB7c, WB11, WB12 and hyphens:
+				// continue from the character after the middle one
+				j = k
+				continue
+			}
+		}
+		if (last == 's' || last == 'S') && (r == '\'' || r == '’') {
+			// plural possessive
+			q, _, ok := peekBase(data, j+n, atEOF)
+			if !ok {
+				return 0, false, false, false
+			}
+			if !isAHLetter(q) && q != numeric {
+				j += n
+			}
+		}
+		return j, letters, digits, true
+	}
+}
+
+// joins reports whether there's no word boundary between a character
+// of property prev and one of property p.
+func joins(prev, p prop) bool {
+	switch prev {
+	case aLetter, hebrewLetter:
+		// WB5, WB9, WB13a
+		return isAHLetter(p) || p == numeric || p == extendNumLet
+	case numeric:
+		// WB8, WB10, WB13a
+		return p == numeric || isAHLetter(p) || p == extendNumLet
+	case katakana:
+		// WB13, WB13a
+		return p == katakana || p == extendNumLet
+	case extendNumLet:
+		// WB13a, WB13b
+		return isAHLetter(p) || p == numeric || p == katakana || p == extendNumLet
+	}
+	return false
+}
+
+// midJoins reports whether the middle character r, of property p,
+// joins a character of property prev to one of property next.
+func midJoins(prev, p prop, r rune, next prop) bool {
+	switch {
+	case r == '-' || r == '‐':
+		return isAHLetter(next) || next == numeric
+	case prev == hebrewLetter && p == doubleQuote:
+		return next == hebrewLetter
+	case isAHLetter(prev) && (p == midLetter || isMidNumLetQ(p)):
+		return isAHLetter(next)
+	case prev == numeric:
+		return next == numeric
+	}
+	return false
+}
+
+// peek returns the property, the rune and the size of the character at
+// j. p is none at the end of the input. ok is false if more data is
+// needed.
+func peek(data []byte, j int, atEOF bool) (p prop, r rune, n int, ok bool) {
+	if j >= len(data) || !utf8.FullRune(data[j:]) {
+		if !atEOF {
+			return none, 0, 0, false
+		}
+		if j >= len(data) {
+			return none, 0, 0, true
+		}
+	}
+	r, n = utf8.DecodeRune(data[j:])
+	return property(r), r, n, true
+}
+
+// peekBase is like peek but skips Extend, Format and ZWJ characters
+// (WB4). It returns the property and the index of the first other
+// character.
+func peekBase(data []byte, j int, atEOF bool) (p prop, start int, ok bool) {
+	for {
+		p, _, n, ok := peek(data, j, atEOF)
+		if !ok || p == none || p != extend && p != format && p != zwj {
+			return p, j, ok
+		}
+		j += n
+	}
+}
@@ -0,0 +1,181 @@
+/*  Copyright (C) 2018 Alexandru Cojocaru
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as
+published by the Free Software Foundation, either version 3 of the
+License, or (at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>. */
+
+package tokenize_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+
+	"xojoc.pw/nlp/tokenize"
+)
+
+// scan returns the tokens of r formatted as text/Kind.
+func scan(t *testing.T, r io.Reader, elisions map[string]bool) []string {
+	s := tokenize.NewScanner(r)
+	s.Elisions = elisions
+	var toks []string
+	for s.Scan() {
+		tok := s.Token()
+		toks = append(toks, string(tok.Bytes)+"/"+tok.Kind.String())
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return toks
+}
+
+var scanTests = []struct {
+	text string
+	toks string
+}{
+	{"", ""},
+	{"  ...  ", ""},
+	{"The quick (“brown”) fox can’t jump 32.3 feet, right?",
+		"The/Word quick/Word brown/Word fox/Word can’t/Word jump/Word 32.3/Number feet/Word right/Word"},
+	{"the dog's bone, the dogs' bones, the boss’ car",
+		"the/Word dog's/Word bone/Word the/Word dogs'/Word bones/Word the/Word boss’/Word car/Word"},
+	{"'tis 'twas", "tis/Word twas/Word"},
+	{"I'm sure they'll say 'hello'", "I'm/Word sure/Word they'll/Word say/Word hello/Word"},
+	{"a state-of-the-art, well‐known e-mail - not -this", "a/Word state-of-the-art/Word well‐known/Word e-mail/Word not/Word this/Word"},
+	{"1,000.50 3rd 2018-10-18 -5 __init__ ___", "1,000.50/Number 3rd/Word 2018-10-18/Number 5/Number __init__/Word"},
+	{"U.S.A. e.g. foo.bar", "U.S.A/Word e.g/Word foo.bar/Word"},
+	{"see https://example.com/a_(b)?c=1&d=2. Or (www.example.org), ftp://x.y/z!",
+		"see/Word https://example.com/a_(b)?c=1&d=2/URL Or/Word www.example.org/URL ftp://x.y/z/URL"},
+	{"http", "http/Word"},
+	{"mail john.doe+nlp@mail.example.com. or x@y, a@b.c", "mail/Word john.doe+nlp@mail.example.com/Email or/Word x/Word y/Word a@b.c/Email"},
+	{"naïve café Straße", "naïve/Word café/Word Straße/Word"},
+	{"日本語のテキスト", "日/Word 本/Word 語/Word の/Word テキスト/Word"},
+	{"שָׁלוֹם צה\"ל", "שָׁלוֹם/Word צה\"ל/Word"},
+	{"emoji 👍🏽 done", "emoji/Word done/Word"},
+}
+
+func TestScanner(t *testing.T) {
+	for _, tt := range scanTests {
+		want := strings.Fields(tt.toks)
+		for _, r := range []io.Reader{strings.NewReader(tt.text), iotest.OneByteReader(strings.NewReader(tt.text))} {
+			got := scan(t, r, nil)
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("%q\n got %v\nwant %v", tt.text, got, want)
+			}
+		}
+	}
+}
+
+func TestElisions(t *testing.T) {
+	text := "L'amico dell’anno, c'è un po' d'acqua nell'Adige e all'alba quest'uomo"
+	want := "L'/Elision amico/Word dell’/Elision anno/Word c'/Elision è/Word un/Word po/Word d'/Elision acqua/Word nell'/Elision Adige/Word e/Word all'/Elision alba/Word quest'/Elision uomo/Word"
+	for _, r := range []io.Reader{strings.NewReader(text), iotest.OneByteReader(strings.NewReader(text))} {
+		got := scan(t, r, tokenize.ItalianElisions)
+		if fmt.Sprint(got) != fmt.Sprint(strings.Fields(want)) {
+			t.Errorf("got %v\nwant %v", got, strings.Fields(want))
+		}
+	}
+	if got := scan(t, strings.NewReader("l'amico"), nil); len(got) != 1 {
+		t.Errorf("elisions split without Elisions: %v", got)
+	}
+}
+
+func TestOffsets(t *testing.T) {
+	text := "  Città: l'amico, 42 — https://x.org."
+	for _, tok := range tokenize.Split(text) {
+		if text[tok.Start:tok.End] != string(tok.Bytes) {
+			t.Errorf("token %q at [%d:%d] is %q", tok.Bytes, tok.Start, tok.End, text[tok.Start:tok.End])
+		}
+	}
+	toks := tokenize.Split(text)
+	if len(toks) != 4 || toks[0].Start != 2 || toks[3].End != len(text)-1 {
+		t.Errorf("Split(%q) = %v", text, toks)
+	}
+}
+
+func TestLongInput(t *testing.T) {
+	text := strings.Repeat("word, ", 100000)
+	n := 0
+	s := tokenize.NewScanner(strings.NewReader(text))
+	for s.Scan() {
+		if tok := s.Token(); tok.Start != n*6 {
+			t.Fatalf("token %d starts at %d", n, tok.Start)
+		}
+		n++
+	}
+	if s.Err() != nil || n != 100000 {
+		t.Errorf("got %d tokens, error %v", n, s.Err())
+	}
+}
+
+func TestLongToken(t *testing.T) {
+	for _, text := range []string{
+		"a " + strings.Repeat("è", 50000) + " b",
+		"a http://x.org/" + strings.Repeat("x", 100000) + " b",
+		"a " + strings.Repeat("_", 100000) + " b",
+	} {
+		var toks []tokenize.Token
+		s := tokenize.NewScanner(strings.NewReader(text))
+		for s.Scan() {
+			tok := s.Token()
+			if !utf8.Valid(tok.Bytes) || len(tok.Bytes) > 64*1024 {
+				t.Errorf("token of %d bytes at %d", len(tok.Bytes), tok.Start)
+			}
+			if text[tok.Start:tok.End] != string(tok.Bytes) {
+				t.Errorf("token at [%d:%d] doesn't match the input", tok.Start, tok.End)
+			}
+			tok.Bytes = nil
+			toks = append(toks, tok)
+		}
+		if err := s.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if len(toks) < 2 || toks[0].End != 1 || toks[len(toks)-1].Start != len(text)-1 {
+			t.Errorf("got tokens %v", toks)
+		}
+	}
+}
+
+func BenchmarkScanner(b *testing.B) {
+	var text strings.Builder
+	for i := 0; i < 100; i++ {
+		for _, tt := range scanTests {
+			text.WriteString(tt.text + "\n")
+		}
+	}
+	b.SetBytes(int64(text.Len()))
+	for i := 0; i < b.N; i++ {
+		s := tokenize.NewScanner(strings.NewReader(text.String()))
+		for s.Scan() {
+		}
+	}
+}
+
+func ExampleScanner() {
+	s := tokenize.NewScanner(strings.NewReader("L'amico di Marco scrive a marco@example.com."))
+	s.Elisions = tokenize.ItalianElisions
+	for s.Scan() {
+		tok := s.Token()
+		fmt.Println(tok.Start, tok.End, tok.Kind, string(tok.Bytes))
+	}
+	//Output:
+	// 0 2 Elision L'
+	// 2 7 Word amico
+	// 8 10 Word di
+	// 11 16 Word Marco
+	// 17 23 Word scrive
+	// 24 25 Word a
+	// 26 43 Email marco@example.com
+}